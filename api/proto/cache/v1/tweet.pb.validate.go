@@ -0,0 +1,670 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/proto/cache/v1/tweet.proto
+
+package cachepb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Tweet with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Tweet) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tweet with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TweetMultiError, or nil if none found.
+func (m *Tweet) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tweet) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Text
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TweetValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TweetValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TweetValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TweetValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TweetValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TweetValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UserId
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetPoll()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TweetValidationError{
+					field:  "Poll",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TweetValidationError{
+					field:  "Poll",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPoll()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TweetValidationError{
+				field:  "Poll",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetMedia() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TweetValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TweetValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TweetValidationError{
+					field:  fmt.Sprintf("Media[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TweetMultiError(errors)
+	}
+
+	return nil
+}
+
+// TweetMultiError is an error wrapping multiple validation errors returned by
+// Tweet.ValidateAll() if the designated constraints aren't met.
+type TweetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TweetMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TweetMultiError) AllErrors() []error { return m }
+
+// TweetValidationError is the validation error returned by Tweet.Validate if
+// the designated constraints aren't met.
+type TweetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TweetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TweetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TweetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TweetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TweetValidationError) ErrorName() string { return "TweetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TweetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTweet.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TweetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TweetValidationError{}
+
+// Validate checks the field values on Poll with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Poll) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Poll with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in PollMultiError, or nil if none found.
+func (m *Poll) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Poll) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOptions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PollValidationError{
+						field:  fmt.Sprintf("Options[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PollValidationError{
+						field:  fmt.Sprintf("Options[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PollValidationError{
+					field:  fmt.Sprintf("Options[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetClosesAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PollValidationError{
+					field:  "ClosesAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PollValidationError{
+					field:  "ClosesAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClosesAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PollValidationError{
+				field:  "ClosesAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PollMultiError(errors)
+	}
+
+	return nil
+}
+
+// PollMultiError is an error wrapping multiple validation errors returned by
+// Poll.ValidateAll() if the designated constraints aren't met.
+type PollMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PollMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PollMultiError) AllErrors() []error { return m }
+
+// PollValidationError is the validation error returned by Poll.Validate if the
+// designated constraints aren't met.
+type PollValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PollValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PollValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PollValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PollValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PollValidationError) ErrorName() string { return "PollValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PollValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPoll.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PollValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PollValidationError{}
+
+// Validate checks the field values on PollOption with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PollOption) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PollOption with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PollOptionMultiError, or
+// nil if none found.
+func (m *PollOption) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PollOption) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Position
+
+	// no validation rules for Text
+
+	// no validation rules for Votes
+
+	if len(errors) > 0 {
+		return PollOptionMultiError(errors)
+	}
+
+	return nil
+}
+
+// PollOptionMultiError is an error wrapping multiple validation errors
+// returned by PollOption.ValidateAll() if the designated constraints aren't met.
+type PollOptionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PollOptionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PollOptionMultiError) AllErrors() []error { return m }
+
+// PollOptionValidationError is the validation error returned by
+// PollOption.Validate if the designated constraints aren't met.
+type PollOptionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PollOptionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PollOptionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PollOptionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PollOptionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PollOptionValidationError) ErrorName() string { return "PollOptionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PollOptionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPollOption.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PollOptionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PollOptionValidationError{}
+
+// Validate checks the field values on Media with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Media) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Media with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MediaMultiError, or nil if none found.
+func (m *Media) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Media) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for MimeType
+
+	// no validation rules for Size
+
+	// no validation rules for Width
+
+	// no validation rules for Height
+
+	// no validation rules for AltText
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MediaValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MediaValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MediaValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MediaMultiError(errors)
+	}
+
+	return nil
+}
+
+// MediaMultiError is an error wrapping multiple validation errors returned by
+// Media.ValidateAll() if the designated constraints aren't met.
+type MediaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MediaMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MediaMultiError) AllErrors() []error { return m }
+
+// MediaValidationError is the validation error returned by Media.Validate if
+// the designated constraints aren't met.
+type MediaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MediaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MediaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MediaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MediaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MediaValidationError) ErrorName() string { return "MediaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MediaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMedia.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MediaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MediaValidationError{}
@@ -25,9 +25,60 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type AccessStatus int32
+
+const (
+	AccessStatus_ACCESS_STATUS_NONE     AccessStatus = 0
+	AccessStatus_ACCESS_STATUS_PENDING  AccessStatus = 1
+	AccessStatus_ACCESS_STATUS_APPROVED AccessStatus = 2
+)
+
+// Enum value maps for AccessStatus.
+var (
+	AccessStatus_name = map[int32]string{
+		0: "ACCESS_STATUS_NONE",
+		1: "ACCESS_STATUS_PENDING",
+		2: "ACCESS_STATUS_APPROVED",
+	}
+	AccessStatus_value = map[string]int32{
+		"ACCESS_STATUS_NONE":     0,
+		"ACCESS_STATUS_PENDING":  1,
+		"ACCESS_STATUS_APPROVED": 2,
+	}
+)
+
+func (x AccessStatus) Enum() *AccessStatus {
+	p := new(AccessStatus)
+	*p = x
+	return p
+}
+
+func (x AccessStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccessStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_v1_service_proto_enumTypes[0].Descriptor()
+}
+
+func (AccessStatus) Type() protoreflect.EnumType {
+	return &file_api_proto_v1_service_proto_enumTypes[0]
+}
+
+func (x AccessStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccessStatus.Descriptor instead.
+func (AccessStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{0}
+}
+
 type CreateTweetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Poll          *CreatePoll            `protobuf:"bytes,2,opt,name=poll,proto3" json:"poll,omitempty"`
+	Media         []*MediaAttachment     `protobuf:"bytes,3,rep,name=media,proto3" json:"media,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -69,6 +120,20 @@ func (x *CreateTweetRequest) GetText() string {
 	return ""
 }
 
+func (x *CreateTweetRequest) GetPoll() *CreatePoll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+func (x *CreateTweetRequest) GetMedia() []*MediaAttachment {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
 type CreateTweetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Tweet         *Tweet                 `protobuf:"bytes,1,opt,name=tweet,proto3" json:"tweet,omitempty"`
@@ -201,16 +266,168 @@ func (x *GetTweetByIDResponse) GetTweet() *Tweet {
 	return nil
 }
 
+type BatchGetTweetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetTweetsRequest) Reset() {
+	*x = BatchGetTweetsRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetTweetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetTweetsRequest) ProtoMessage() {}
+
+func (x *BatchGetTweetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetTweetsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetTweetsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchGetTweetsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// BatchGetTweetsResponse содержит результат на каждый id запроса в том же порядке.
+// Удаленные и недоступные читателю твиты помечаются not_found
+type BatchGetTweetsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Results       []*BatchGetTweetsResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetTweetsResponse) Reset() {
+	*x = BatchGetTweetsResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetTweetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetTweetsResponse) ProtoMessage() {}
+
+func (x *BatchGetTweetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetTweetsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetTweetsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchGetTweetsResponse) GetResults() []*BatchGetTweetsResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchGetTweetsResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tweet         *Tweet                 `protobuf:"bytes,2,opt,name=tweet,proto3" json:"tweet,omitempty"`
+	NotFound      bool                   `protobuf:"varint,3,opt,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetTweetsResult) Reset() {
+	*x = BatchGetTweetsResult{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetTweetsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetTweetsResult) ProtoMessage() {}
+
+func (x *BatchGetTweetsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetTweetsResult.ProtoReflect.Descriptor instead.
+func (*BatchGetTweetsResult) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BatchGetTweetsResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BatchGetTweetsResult) GetTweet() *Tweet {
+	if x != nil {
+		return x.Tweet
+	}
+	return nil
+}
+
+func (x *BatchGetTweetsResult) GetNotFound() bool {
+	if x != nil {
+		return x.NotFound
+	}
+	return false
+}
+
 type GetUserTweetsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetUserTweetsRequest) Reset() {
 	*x = GetUserTweetsRequest{}
-	mi := &file_api_proto_v1_service_proto_msgTypes[4]
+	mi := &file_api_proto_v1_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -222,7 +439,7 @@ func (x *GetUserTweetsRequest) String() string {
 func (*GetUserTweetsRequest) ProtoMessage() {}
 
 func (x *GetUserTweetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_service_proto_msgTypes[4]
+	mi := &file_api_proto_v1_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -235,7 +452,7 @@ func (x *GetUserTweetsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserTweetsRequest.ProtoReflect.Descriptor instead.
 func (*GetUserTweetsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{4}
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetUserTweetsRequest) GetUserId() string {
@@ -245,16 +462,31 @@ func (x *GetUserTweetsRequest) GetUserId() string {
 	return ""
 }
 
+func (x *GetUserTweetsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetUserTweetsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type GetUserTweetsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Tweets        []*Tweet               `protobuf:"bytes,1,rep,name=tweets,proto3" json:"tweets,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetUserTweetsResponse) Reset() {
 	*x = GetUserTweetsResponse{}
-	mi := &file_api_proto_v1_service_proto_msgTypes[5]
+	mi := &file_api_proto_v1_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -266,7 +498,7 @@ func (x *GetUserTweetsResponse) String() string {
 func (*GetUserTweetsResponse) ProtoMessage() {}
 
 func (x *GetUserTweetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_service_proto_msgTypes[5]
+	mi := &file_api_proto_v1_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -279,7 +511,7 @@ func (x *GetUserTweetsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserTweetsResponse.ProtoReflect.Descriptor instead.
 func (*GetUserTweetsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{5}
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetUserTweetsResponse) GetTweets() []*Tweet {
@@ -289,17 +521,27 @@ func (x *GetUserTweetsResponse) GetTweets() []*Tweet {
 	return nil
 }
 
+func (x *GetUserTweetsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type UpdateTweetRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text  string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// expected_version если задан, обновление пройдет только при совпадении с текущей версией твита.
+	// Через gateway ту же проверку задает заголовок If-Match
+	ExpectedVersion int64 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UpdateTweetRequest) Reset() {
 	*x = UpdateTweetRequest{}
-	mi := &file_api_proto_v1_service_proto_msgTypes[6]
+	mi := &file_api_proto_v1_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -311,7 +553,7 @@ func (x *UpdateTweetRequest) String() string {
 func (*UpdateTweetRequest) ProtoMessage() {}
 
 func (x *UpdateTweetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_service_proto_msgTypes[6]
+	mi := &file_api_proto_v1_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -324,7 +566,7 @@ func (x *UpdateTweetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTweetRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTweetRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{6}
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateTweetRequest) GetId() string {
@@ -341,6 +583,13 @@ func (x *UpdateTweetRequest) GetText() string {
 	return ""
 }
 
+func (x *UpdateTweetRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 type UpdateTweetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Tweet         *Tweet                 `protobuf:"bytes,1,opt,name=tweet,proto3" json:"tweet,omitempty"`
@@ -350,7 +599,7 @@ type UpdateTweetResponse struct {
 
 func (x *UpdateTweetResponse) Reset() {
 	*x = UpdateTweetResponse{}
-	mi := &file_api_proto_v1_service_proto_msgTypes[7]
+	mi := &file_api_proto_v1_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -362,7 +611,7 @@ func (x *UpdateTweetResponse) String() string {
 func (*UpdateTweetResponse) ProtoMessage() {}
 
 func (x *UpdateTweetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_service_proto_msgTypes[7]
+	mi := &file_api_proto_v1_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -375,7 +624,7 @@ func (x *UpdateTweetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTweetResponse.ProtoReflect.Descriptor instead.
 func (*UpdateTweetResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{7}
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateTweetResponse) GetTweet() *Tweet {
@@ -394,7 +643,7 @@ type DeleteTweetRequest struct {
 
 func (x *DeleteTweetRequest) Reset() {
 	*x = DeleteTweetRequest{}
-	mi := &file_api_proto_v1_service_proto_msgTypes[8]
+	mi := &file_api_proto_v1_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -406,7 +655,7 @@ func (x *DeleteTweetRequest) String() string {
 func (*DeleteTweetRequest) ProtoMessage() {}
 
 func (x *DeleteTweetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_service_proto_msgTypes[8]
+	mi := &file_api_proto_v1_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -419,7 +668,7 @@ func (x *DeleteTweetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTweetRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTweetRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{8}
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DeleteTweetRequest) GetId() string {
@@ -437,7 +686,7 @@ type DeleteTweetResponse struct {
 
 func (x *DeleteTweetResponse) Reset() {
 	*x = DeleteTweetResponse{}
-	mi := &file_api_proto_v1_service_proto_msgTypes[9]
+	mi := &file_api_proto_v1_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -449,7 +698,7 @@ func (x *DeleteTweetResponse) String() string {
 func (*DeleteTweetResponse) ProtoMessage() {}
 
 func (x *DeleteTweetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_service_proto_msgTypes[9]
+	mi := &file_api_proto_v1_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -462,19 +711,21 @@ func (x *DeleteTweetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTweetResponse.ProtoReflect.Descriptor instead.
 func (*DeleteTweetResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{9}
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{12}
 }
 
 type GetSubscribersTweetsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserIds       []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetSubscribersTweetsRequest) Reset() {
 	*x = GetSubscribersTweetsRequest{}
-	mi := &file_api_proto_v1_service_proto_msgTypes[10]
+	mi := &file_api_proto_v1_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -486,7 +737,7 @@ func (x *GetSubscribersTweetsRequest) String() string {
 func (*GetSubscribersTweetsRequest) ProtoMessage() {}
 
 func (x *GetSubscribersTweetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_service_proto_msgTypes[10]
+	mi := &file_api_proto_v1_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -499,7 +750,7 @@ func (x *GetSubscribersTweetsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubscribersTweetsRequest.ProtoReflect.Descriptor instead.
 func (*GetSubscribersTweetsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{10}
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetSubscribersTweetsRequest) GetUserIds() []string {
@@ -509,16 +760,31 @@ func (x *GetSubscribersTweetsRequest) GetUserIds() []string {
 	return nil
 }
 
+func (x *GetSubscribersTweetsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetSubscribersTweetsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type GetSubscribersTweetsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Tweets        []*Tweet               `protobuf:"bytes,1,rep,name=tweets,proto3" json:"tweets,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetSubscribersTweetsResponse) Reset() {
 	*x = GetSubscribersTweetsResponse{}
-	mi := &file_api_proto_v1_service_proto_msgTypes[11]
+	mi := &file_api_proto_v1_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -530,7 +796,7 @@ func (x *GetSubscribersTweetsResponse) String() string {
 func (*GetSubscribersTweetsResponse) ProtoMessage() {}
 
 func (x *GetSubscribersTweetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_service_proto_msgTypes[11]
+	mi := &file_api_proto_v1_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -543,7 +809,7 @@ func (x *GetSubscribersTweetsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubscribersTweetsResponse.ProtoReflect.Descriptor instead.
 func (*GetSubscribersTweetsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{11}
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetSubscribersTweetsResponse) GetTweets() []*Tweet {
@@ -553,6 +819,13 @@ func (x *GetSubscribersTweetsResponse) GetTweets() []*Tweet {
 	return nil
 }
 
+func (x *GetSubscribersTweetsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type Tweet struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -560,13 +833,18 @@ type Tweet struct {
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Poll          *Poll                  `protobuf:"bytes,6,opt,name=poll,proto3" json:"poll,omitempty"`
+	Media         []*Media               `protobuf:"bytes,7,rep,name=media,proto3" json:"media,omitempty"`
+	Bookmarked    bool                   `protobuf:"varint,8,opt,name=bookmarked,proto3" json:"bookmarked,omitempty"`
+	Author        *Author                `protobuf:"bytes,9,opt,name=author,proto3" json:"author,omitempty"`
+	Version       int64                  `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Tweet) Reset() {
 	*x = Tweet{}
-	mi := &file_api_proto_v1_service_proto_msgTypes[12]
+	mi := &file_api_proto_v1_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -578,7 +856,7 @@ func (x *Tweet) String() string {
 func (*Tweet) ProtoMessage() {}
 
 func (x *Tweet) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_v1_service_proto_msgTypes[12]
+	mi := &file_api_proto_v1_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -591,7 +869,7 @@ func (x *Tweet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Tweet.ProtoReflect.Descriptor instead.
 func (*Tweet) Descriptor() ([]byte, []int) {
-	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{12}
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Tweet) GetId() string {
@@ -629,54 +907,3973 @@ func (x *Tweet) GetUserId() string {
 	return ""
 }
 
-var File_api_proto_v1_service_proto protoreflect.FileDescriptor
+func (x *Tweet) GetPoll() *Poll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
 
-const file_api_proto_v1_service_proto_rawDesc = "" +
-	"\n" +
-	"\x1aapi/proto/v1/service.proto\x12\fapi.proto.v1\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x15google/rpc/code.proto\"4\n" +
-	"\x12CreateTweetRequest\x12\x1e\n" +
-	"\x04text\x18\x01 \x01(\tB\n" +
-	"\xfaB\ar\x05\x10\x01\x18\xfa\x01R\x04text\"@\n" +
-	"\x13CreateTweetResponse\x12)\n" +
-	"\x05tweet\x18\x01 \x01(\v2\x13.api.proto.v1.TweetR\x05tweet\"/\n" +
-	"\x13GetTweetByIDRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\"A\n" +
-	"\x14GetTweetByIDResponse\x12)\n" +
-	"\x05tweet\x18\x01 \x01(\v2\x13.api.proto.v1.TweetR\x05tweet\"9\n" +
-	"\x14GetUserTweetsRequest\x12!\n" +
-	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\"D\n" +
-	"\x15GetUserTweetsResponse\x12+\n" +
-	"\x06tweets\x18\x01 \x03(\v2\x13.api.proto.v1.TweetR\x06tweets\"N\n" +
-	"\x12UpdateTweetRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x12\x1e\n" +
-	"\x04text\x18\x02 \x01(\tB\n" +
-	"\xfaB\ar\x05\x10\x01\x18\xfa\x01R\x04text\"@\n" +
-	"\x13UpdateTweetResponse\x12)\n" +
-	"\x05tweet\x18\x01 \x01(\v2\x13.api.proto.v1.TweetR\x05tweet\".\n" +
-	"\x12DeleteTweetRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\"\x15\n" +
-	"\x13DeleteTweetResponse\"8\n" +
-	"\x1bGetSubscribersTweetsRequest\x12\x19\n" +
-	"\buser_ids\x18\x01 \x03(\tR\auserIds\"K\n" +
-	"\x1cGetSubscribersTweetsResponse\x12+\n" +
-	"\x06tweets\x18\x01 \x03(\v2\x13.api.proto.v1.TweetR\x06tweets\"\xda\x01\n" +
-	"\x05Tweet\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x12\x1e\n" +
-	"\x04text\x18\x02 \x01(\tB\n" +
-	"\xfaB\ar\x05\x10\x01\x18\xfa\x01R\x04text\x129\n" +
-	"\n" +
-	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
+func (x *Tweet) GetMedia() []*Media {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *Tweet) GetBookmarked() bool {
+	if x != nil {
+		return x.Bookmarked
+	}
+	return false
+}
+
+func (x *Tweet) GetAuthor() *Author {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *Tweet) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Author краткий профиль автора внутри твита. Отсутствует, если автор еще не заполнил профиль
+type Author struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Author) Reset() {
+	*x = Author{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Author) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Author) ProtoMessage() {}
+
+func (x *Author) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Author.ProtoReflect.Descriptor instead.
+func (*Author) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Author) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Author) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Author) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Author) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+type CreatePoll struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Options       []string               `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	ClosesAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePoll) Reset() {
+	*x = CreatePoll{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePoll) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePoll) ProtoMessage() {}
+
+func (x *CreatePoll) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePoll.ProtoReflect.Descriptor instead.
+func (*CreatePoll) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreatePoll) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreatePoll) GetClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return nil
+}
+
+type Poll struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Options       []*PollOption          `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	ClosesAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`
+	Closed        bool                   `protobuf:"varint,3,opt,name=closed,proto3" json:"closed,omitempty"`
+	TotalVotes    int64                  `protobuf:"varint,4,opt,name=total_votes,json=totalVotes,proto3" json:"total_votes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Poll) Reset() {
+	*x = Poll{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Poll) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Poll) ProtoMessage() {}
+
+func (x *Poll) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Poll.ProtoReflect.Descriptor instead.
+func (*Poll) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Poll) GetOptions() []*PollOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Poll) GetClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return nil
+}
+
+func (x *Poll) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+func (x *Poll) GetTotalVotes() int64 {
+	if x != nil {
+		return x.TotalVotes
+	}
+	return 0
+}
+
+type PollOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Position      int32                  `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Votes         int64                  `protobuf:"varint,3,opt,name=votes,proto3" json:"votes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PollOption) Reset() {
+	*x = PollOption{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollOption) ProtoMessage() {}
+
+func (x *PollOption) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollOption.ProtoReflect.Descriptor instead.
+func (*PollOption) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PollOption) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *PollOption) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PollOption) GetVotes() int64 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+type VotePollRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TweetId       string                 `protobuf:"bytes,1,opt,name=tweet_id,json=tweetId,proto3" json:"tweet_id,omitempty"`
+	Position      int32                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VotePollRequest) Reset() {
+	*x = VotePollRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VotePollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotePollRequest) ProtoMessage() {}
+
+func (x *VotePollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotePollRequest.ProtoReflect.Descriptor instead.
+func (*VotePollRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *VotePollRequest) GetTweetId() string {
+	if x != nil {
+		return x.TweetId
+	}
+	return ""
+}
+
+func (x *VotePollRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type VotePollResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Poll          *Poll                  `protobuf:"bytes,1,opt,name=poll,proto3" json:"poll,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VotePollResponse) Reset() {
+	*x = VotePollResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VotePollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotePollResponse) ProtoMessage() {}
+
+func (x *VotePollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotePollResponse.ProtoReflect.Descriptor instead.
+func (*VotePollResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *VotePollResponse) GetPoll() *Poll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+type UploadMediaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadMediaRequest_Info
+	//	*UploadMediaRequest_Chunk
+	Payload       isUploadMediaRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadMediaRequest) Reset() {
+	*x = UploadMediaRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadMediaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadMediaRequest) ProtoMessage() {}
+
+func (x *UploadMediaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadMediaRequest.ProtoReflect.Descriptor instead.
+func (*UploadMediaRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UploadMediaRequest) GetPayload() isUploadMediaRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UploadMediaRequest) GetInfo() *MediaInfo {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadMediaRequest_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *UploadMediaRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadMediaRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadMediaRequest_Payload interface {
+	isUploadMediaRequest_Payload()
+}
+
+type UploadMediaRequest_Info struct {
+	Info *MediaInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type UploadMediaRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadMediaRequest_Info) isUploadMediaRequest_Payload() {}
+
+func (*UploadMediaRequest_Chunk) isUploadMediaRequest_Payload() {}
+
+type MediaInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MimeType      string                 `protobuf:"bytes,1,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MediaInfo) Reset() {
+	*x = MediaInfo{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaInfo) ProtoMessage() {}
+
+func (x *MediaInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaInfo.ProtoReflect.Descriptor instead.
+func (*MediaInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MediaInfo) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+type UploadMediaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Media         *Media                 `protobuf:"bytes,1,opt,name=media,proto3" json:"media,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadMediaResponse) Reset() {
+	*x = UploadMediaResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadMediaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadMediaResponse) ProtoMessage() {}
+
+func (x *UploadMediaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadMediaResponse.ProtoReflect.Descriptor instead.
+func (*UploadMediaResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UploadMediaResponse) GetMedia() *Media {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+type MediaAttachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MediaId       string                 `protobuf:"bytes,1,opt,name=media_id,json=mediaId,proto3" json:"media_id,omitempty"`
+	AltText       string                 `protobuf:"bytes,2,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MediaAttachment) Reset() {
+	*x = MediaAttachment{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaAttachment) ProtoMessage() {}
+
+func (x *MediaAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaAttachment.ProtoReflect.Descriptor instead.
+func (*MediaAttachment) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MediaAttachment) GetMediaId() string {
+	if x != nil {
+		return x.MediaId
+	}
+	return ""
+}
+
+func (x *MediaAttachment) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+type Media struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MimeType      string                 `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Width         int32                  `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Url           string                 `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	ThumbnailUrl  string                 `protobuf:"bytes,7,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	AltText       string                 `protobuf:"bytes,8,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Media) Reset() {
+	*x = Media{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Media) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Media) ProtoMessage() {}
+
+func (x *Media) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Media.ProtoReflect.Descriptor instead.
+func (*Media) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Media) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Media) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *Media) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Media) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Media) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Media) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Media) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *Media) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+type AddBookmarkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TweetId       string                 `protobuf:"bytes,1,opt,name=tweet_id,json=tweetId,proto3" json:"tweet_id,omitempty"`
+	FolderId      string                 `protobuf:"bytes,2,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBookmarkRequest) Reset() {
+	*x = AddBookmarkRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBookmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBookmarkRequest) ProtoMessage() {}
+
+func (x *AddBookmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBookmarkRequest.ProtoReflect.Descriptor instead.
+func (*AddBookmarkRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AddBookmarkRequest) GetTweetId() string {
+	if x != nil {
+		return x.TweetId
+	}
+	return ""
+}
+
+func (x *AddBookmarkRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+type AddBookmarkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBookmarkResponse) Reset() {
+	*x = AddBookmarkResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBookmarkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBookmarkResponse) ProtoMessage() {}
+
+func (x *AddBookmarkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBookmarkResponse.ProtoReflect.Descriptor instead.
+func (*AddBookmarkResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{28}
+}
+
+type RemoveBookmarkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TweetId       string                 `protobuf:"bytes,1,opt,name=tweet_id,json=tweetId,proto3" json:"tweet_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveBookmarkRequest) Reset() {
+	*x = RemoveBookmarkRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveBookmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBookmarkRequest) ProtoMessage() {}
+
+func (x *RemoveBookmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBookmarkRequest.ProtoReflect.Descriptor instead.
+func (*RemoveBookmarkRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RemoveBookmarkRequest) GetTweetId() string {
+	if x != nil {
+		return x.TweetId
+	}
+	return ""
+}
+
+type RemoveBookmarkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveBookmarkResponse) Reset() {
+	*x = RemoveBookmarkResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveBookmarkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBookmarkResponse) ProtoMessage() {}
+
+func (x *RemoveBookmarkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBookmarkResponse.ProtoReflect.Descriptor instead.
+func (*RemoveBookmarkResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{30}
+}
+
+type ListBookmarksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderId      string                 `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBookmarksRequest) Reset() {
+	*x = ListBookmarksRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookmarksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookmarksRequest) ProtoMessage() {}
+
+func (x *ListBookmarksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookmarksRequest.ProtoReflect.Descriptor instead.
+func (*ListBookmarksRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListBookmarksRequest) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *ListBookmarksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListBookmarksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListBookmarksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tweets        []*Tweet               `protobuf:"bytes,1,rep,name=tweets,proto3" json:"tweets,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBookmarksResponse) Reset() {
+	*x = ListBookmarksResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookmarksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookmarksResponse) ProtoMessage() {}
+
+func (x *ListBookmarksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookmarksResponse.ProtoReflect.Descriptor instead.
+func (*ListBookmarksResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListBookmarksResponse) GetTweets() []*Tweet {
+	if x != nil {
+		return x.Tweets
+	}
+	return nil
+}
+
+func (x *ListBookmarksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type BookmarkFolder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookmarkFolder) Reset() {
+	*x = BookmarkFolder{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookmarkFolder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookmarkFolder) ProtoMessage() {}
+
+func (x *BookmarkFolder) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookmarkFolder.ProtoReflect.Descriptor instead.
+func (*BookmarkFolder) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BookmarkFolder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BookmarkFolder) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BookmarkFolder) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateBookmarkFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBookmarkFolderRequest) Reset() {
+	*x = CreateBookmarkFolderRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookmarkFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookmarkFolderRequest) ProtoMessage() {}
+
+func (x *CreateBookmarkFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookmarkFolderRequest.ProtoReflect.Descriptor instead.
+func (*CreateBookmarkFolderRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreateBookmarkFolderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateBookmarkFolderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folder        *BookmarkFolder        `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBookmarkFolderResponse) Reset() {
+	*x = CreateBookmarkFolderResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookmarkFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookmarkFolderResponse) ProtoMessage() {}
+
+func (x *CreateBookmarkFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookmarkFolderResponse.ProtoReflect.Descriptor instead.
+func (*CreateBookmarkFolderResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateBookmarkFolderResponse) GetFolder() *BookmarkFolder {
+	if x != nil {
+		return x.Folder
+	}
+	return nil
+}
+
+type ListBookmarkFoldersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBookmarkFoldersRequest) Reset() {
+	*x = ListBookmarkFoldersRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookmarkFoldersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookmarkFoldersRequest) ProtoMessage() {}
+
+func (x *ListBookmarkFoldersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookmarkFoldersRequest.ProtoReflect.Descriptor instead.
+func (*ListBookmarkFoldersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{36}
+}
+
+type ListBookmarkFoldersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folders       []*BookmarkFolder      `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBookmarkFoldersResponse) Reset() {
+	*x = ListBookmarkFoldersResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookmarkFoldersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookmarkFoldersResponse) ProtoMessage() {}
+
+func (x *ListBookmarkFoldersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookmarkFoldersResponse.ProtoReflect.Descriptor instead.
+func (*ListBookmarkFoldersResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListBookmarkFoldersResponse) GetFolders() []*BookmarkFolder {
+	if x != nil {
+		return x.Folders
+	}
+	return nil
+}
+
+type RenameBookmarkFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameBookmarkFolderRequest) Reset() {
+	*x = RenameBookmarkFolderRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameBookmarkFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameBookmarkFolderRequest) ProtoMessage() {}
+
+func (x *RenameBookmarkFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameBookmarkFolderRequest.ProtoReflect.Descriptor instead.
+func (*RenameBookmarkFolderRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RenameBookmarkFolderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RenameBookmarkFolderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameBookmarkFolderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folder        *BookmarkFolder        `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameBookmarkFolderResponse) Reset() {
+	*x = RenameBookmarkFolderResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameBookmarkFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameBookmarkFolderResponse) ProtoMessage() {}
+
+func (x *RenameBookmarkFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameBookmarkFolderResponse.ProtoReflect.Descriptor instead.
+func (*RenameBookmarkFolderResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RenameBookmarkFolderResponse) GetFolder() *BookmarkFolder {
+	if x != nil {
+		return x.Folder
+	}
+	return nil
+}
+
+type DeleteBookmarkFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBookmarkFolderRequest) Reset() {
+	*x = DeleteBookmarkFolderRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBookmarkFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBookmarkFolderRequest) ProtoMessage() {}
+
+func (x *DeleteBookmarkFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBookmarkFolderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBookmarkFolderRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteBookmarkFolderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteBookmarkFolderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBookmarkFolderResponse) Reset() {
+	*x = DeleteBookmarkFolderResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBookmarkFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBookmarkFolderResponse) ProtoMessage() {}
+
+func (x *DeleteBookmarkFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBookmarkFolderResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBookmarkFolderResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{41}
+}
+
+type List struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Private       bool                   `protobuf:"varint,5,opt,name=private,proto3" json:"private,omitempty"`
+	MemberCount   int32                  `protobuf:"varint,6,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *List) Reset() {
+	*x = List{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*List) ProtoMessage() {}
+
+func (x *List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use List.ProtoReflect.Descriptor instead.
+func (*List) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *List) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *List) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *List) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *List) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+func (x *List) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *List) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *List) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Private       bool                   `protobuf:"varint,3,opt,name=private,proto3" json:"private,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateListRequest) Reset() {
+	*x = CreateListRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateListRequest) ProtoMessage() {}
+
+func (x *CreateListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateListRequest.ProtoReflect.Descriptor instead.
+func (*CreateListRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateListRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateListRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateListRequest) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+type CreateListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          *List                  `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateListResponse) Reset() {
+	*x = CreateListResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateListResponse) ProtoMessage() {}
+
+func (x *CreateListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateListResponse.ProtoReflect.Descriptor instead.
+func (*CreateListResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateListResponse) GetList() *List {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type GetListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetListRequest) Reset() {
+	*x = GetListRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListRequest) ProtoMessage() {}
+
+func (x *GetListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListRequest.ProtoReflect.Descriptor instead.
+func (*GetListRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetListRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          *List                  `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetListResponse) Reset() {
+	*x = GetListResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListResponse) ProtoMessage() {}
+
+func (x *GetListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListResponse.ProtoReflect.Descriptor instead.
+func (*GetListResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetListResponse) GetList() *List {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type UpdateListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Private       bool                   `protobuf:"varint,4,opt,name=private,proto3" json:"private,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateListRequest) Reset() {
+	*x = UpdateListRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateListRequest) ProtoMessage() {}
+
+func (x *UpdateListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateListRequest.ProtoReflect.Descriptor instead.
+func (*UpdateListRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UpdateListRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateListRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateListRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateListRequest) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+type UpdateListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          *List                  `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateListResponse) Reset() {
+	*x = UpdateListResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateListResponse) ProtoMessage() {}
+
+func (x *UpdateListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateListResponse.ProtoReflect.Descriptor instead.
+func (*UpdateListResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UpdateListResponse) GetList() *List {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type DeleteListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteListRequest) Reset() {
+	*x = DeleteListRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteListRequest) ProtoMessage() {}
+
+func (x *DeleteListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteListRequest.ProtoReflect.Descriptor instead.
+func (*DeleteListRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeleteListRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteListResponse) Reset() {
+	*x = DeleteListResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteListResponse) ProtoMessage() {}
+
+func (x *DeleteListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteListResponse.ProtoReflect.Descriptor instead.
+func (*DeleteListResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{50}
+}
+
+type GetUserListsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserListsRequest) Reset() {
+	*x = GetUserListsRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserListsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserListsRequest) ProtoMessage() {}
+
+func (x *GetUserListsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserListsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserListsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetUserListsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserListsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lists         []*List                `protobuf:"bytes,1,rep,name=lists,proto3" json:"lists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserListsResponse) Reset() {
+	*x = GetUserListsResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserListsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserListsResponse) ProtoMessage() {}
+
+func (x *GetUserListsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserListsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserListsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetUserListsResponse) GetLists() []*List {
+	if x != nil {
+		return x.Lists
+	}
+	return nil
+}
+
+type AddListMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListId        string                 `protobuf:"bytes,1,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddListMemberRequest) Reset() {
+	*x = AddListMemberRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddListMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddListMemberRequest) ProtoMessage() {}
+
+func (x *AddListMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddListMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddListMemberRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AddListMemberRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+func (x *AddListMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AddListMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddListMemberResponse) Reset() {
+	*x = AddListMemberResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddListMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddListMemberResponse) ProtoMessage() {}
+
+func (x *AddListMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddListMemberResponse.ProtoReflect.Descriptor instead.
+func (*AddListMemberResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{54}
+}
+
+type RemoveListMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListId        string                 `protobuf:"bytes,1,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveListMemberRequest) Reset() {
+	*x = RemoveListMemberRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveListMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveListMemberRequest) ProtoMessage() {}
+
+func (x *RemoveListMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveListMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveListMemberRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RemoveListMemberRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+func (x *RemoveListMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveListMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveListMemberResponse) Reset() {
+	*x = RemoveListMemberResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveListMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveListMemberResponse) ProtoMessage() {}
+
+func (x *RemoveListMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveListMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveListMemberResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{56}
+}
+
+type GetListMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListId        string                 `protobuf:"bytes,1,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetListMembersRequest) Reset() {
+	*x = GetListMembersRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetListMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListMembersRequest) ProtoMessage() {}
+
+func (x *GetListMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListMembersRequest.ProtoReflect.Descriptor instead.
+func (*GetListMembersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetListMembersRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+type GetListMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetListMembersResponse) Reset() {
+	*x = GetListMembersResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetListMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListMembersResponse) ProtoMessage() {}
+
+func (x *GetListMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListMembersResponse.ProtoReflect.Descriptor instead.
+func (*GetListMembersResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetListMembersResponse) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type GetListTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListId        string                 `protobuf:"bytes,1,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetListTimelineRequest) Reset() {
+	*x = GetListTimelineRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetListTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListTimelineRequest) ProtoMessage() {}
+
+func (x *GetListTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetListTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetListTimelineRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+func (x *GetListTimelineRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetListTimelineRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetListTimelineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tweets        []*Tweet               `protobuf:"bytes,1,rep,name=tweets,proto3" json:"tweets,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetListTimelineResponse) Reset() {
+	*x = GetListTimelineResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetListTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListTimelineResponse) ProtoMessage() {}
+
+func (x *GetListTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetListTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetListTimelineResponse) GetTweets() []*Tweet {
+	if x != nil {
+		return x.Tweets
+	}
+	return nil
+}
+
+func (x *GetListTimelineResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type BlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockRequest) Reset() {
+	*x = BlockRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockRequest) ProtoMessage() {}
+
+func (x *BlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockRequest.ProtoReflect.Descriptor instead.
+func (*BlockRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *BlockRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type BlockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockResponse) Reset() {
+	*x = BlockResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockResponse) ProtoMessage() {}
+
+func (x *BlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockResponse.ProtoReflect.Descriptor instead.
+func (*BlockResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{62}
+}
+
+type UnblockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockRequest) Reset() {
+	*x = UnblockRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockRequest) ProtoMessage() {}
+
+func (x *UnblockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockRequest.ProtoReflect.Descriptor instead.
+func (*UnblockRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UnblockRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnblockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockResponse) Reset() {
+	*x = UnblockResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockResponse) ProtoMessage() {}
+
+func (x *UnblockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockResponse.ProtoReflect.Descriptor instead.
+func (*UnblockResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{64}
+}
+
+type MuteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteRequest) Reset() {
+	*x = MuteRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteRequest) ProtoMessage() {}
+
+func (x *MuteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteRequest.ProtoReflect.Descriptor instead.
+func (*MuteRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *MuteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type MuteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteResponse) Reset() {
+	*x = MuteResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteResponse) ProtoMessage() {}
+
+func (x *MuteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteResponse.ProtoReflect.Descriptor instead.
+func (*MuteResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{66}
+}
+
+type UnmuteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmuteRequest) Reset() {
+	*x = UnmuteRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteRequest) ProtoMessage() {}
+
+func (x *UnmuteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteRequest.ProtoReflect.Descriptor instead.
+func (*UnmuteRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UnmuteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnmuteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmuteResponse) Reset() {
+	*x = UnmuteResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteResponse) ProtoMessage() {}
+
+func (x *UnmuteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteResponse.ProtoReflect.Descriptor instead.
+func (*UnmuteResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{68}
+}
+
+type AccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReaderId      string                 `protobuf:"bytes,1,opt,name=reader_id,json=readerId,proto3" json:"reader_id,omitempty"`
+	Status        AccessStatus           `protobuf:"varint,2,opt,name=status,proto3,enum=api.proto.v1.AccessStatus" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessRequest) Reset() {
+	*x = AccessRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessRequest) ProtoMessage() {}
+
+func (x *AccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessRequest.ProtoReflect.Descriptor instead.
+func (*AccessRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *AccessRequest) GetReaderId() string {
+	if x != nil {
+		return x.ReaderId
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetStatus() AccessStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AccessStatus_ACCESS_STATUS_NONE
+}
+
+func (x *AccessRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SetProtectedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Protected     bool                   `protobuf:"varint,1,opt,name=protected,proto3" json:"protected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProtectedRequest) Reset() {
+	*x = SetProtectedRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProtectedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProtectedRequest) ProtoMessage() {}
+
+func (x *SetProtectedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProtectedRequest.ProtoReflect.Descriptor instead.
+func (*SetProtectedRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SetProtectedRequest) GetProtected() bool {
+	if x != nil {
+		return x.Protected
+	}
+	return false
+}
+
+type SetProtectedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Protected     bool                   `protobuf:"varint,1,opt,name=protected,proto3" json:"protected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProtectedResponse) Reset() {
+	*x = SetProtectedResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProtectedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProtectedResponse) ProtoMessage() {}
+
+func (x *SetProtectedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProtectedResponse.ProtoReflect.Descriptor instead.
+func (*SetProtectedResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SetProtectedResponse) GetProtected() bool {
+	if x != nil {
+		return x.Protected
+	}
+	return false
+}
+
+type RequestAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestAccessRequest) Reset() {
+	*x = RequestAccessRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestAccessRequest) ProtoMessage() {}
+
+func (x *RequestAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestAccessRequest.ProtoReflect.Descriptor instead.
+func (*RequestAccessRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *RequestAccessRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RequestAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        AccessStatus           `protobuf:"varint,1,opt,name=status,proto3,enum=api.proto.v1.AccessStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestAccessResponse) Reset() {
+	*x = RequestAccessResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestAccessResponse) ProtoMessage() {}
+
+func (x *RequestAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestAccessResponse.ProtoReflect.Descriptor instead.
+func (*RequestAccessResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *RequestAccessResponse) GetStatus() AccessStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AccessStatus_ACCESS_STATUS_NONE
+}
+
+type ListAccessRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessRequestsRequest) Reset() {
+	*x = ListAccessRequestsRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessRequestsRequest) ProtoMessage() {}
+
+func (x *ListAccessRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccessRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{74}
+}
+
+type ListAccessRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*AccessRequest       `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessRequestsResponse) Reset() {
+	*x = ListAccessRequestsResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessRequestsResponse) ProtoMessage() {}
+
+func (x *ListAccessRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccessRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListAccessRequestsResponse) GetRequests() []*AccessRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type ApproveAccessRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReaderId      string                 `protobuf:"bytes,1,opt,name=reader_id,json=readerId,proto3" json:"reader_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveAccessRequestRequest) Reset() {
+	*x = ApproveAccessRequestRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveAccessRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveAccessRequestRequest) ProtoMessage() {}
+
+func (x *ApproveAccessRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveAccessRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveAccessRequestRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ApproveAccessRequestRequest) GetReaderId() string {
+	if x != nil {
+		return x.ReaderId
+	}
+	return ""
+}
+
+type ApproveAccessRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveAccessRequestResponse) Reset() {
+	*x = ApproveAccessRequestResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveAccessRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveAccessRequestResponse) ProtoMessage() {}
+
+func (x *ApproveAccessRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveAccessRequestResponse.ProtoReflect.Descriptor instead.
+func (*ApproveAccessRequestResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{77}
+}
+
+type RevokeAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReaderId      string                 `protobuf:"bytes,1,opt,name=reader_id,json=readerId,proto3" json:"reader_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAccessRequest) Reset() {
+	*x = RevokeAccessRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAccessRequest) ProtoMessage() {}
+
+func (x *RevokeAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAccessRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAccessRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RevokeAccessRequest) GetReaderId() string {
+	if x != nil {
+		return x.ReaderId
+	}
+	return ""
+}
+
+type RevokeAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAccessResponse) Reset() {
+	*x = RevokeAccessResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAccessResponse) ProtoMessage() {}
+
+func (x *RevokeAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAccessResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAccessResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{79}
+}
+
+type Profile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Bio           string                 `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,5,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	Location      string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	Website       string                 `protobuf:"bytes,7,opt,name=website,proto3" json:"website,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *Profile) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Profile) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Profile) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Profile) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *Profile) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *Profile) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Profile) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *Profile) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Profile) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileRequest) Reset() {
+	*x = GetProfileRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileRequest) ProtoMessage() {}
+
+func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileResponse) Reset() {
+	*x = GetProfileResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileResponse) ProtoMessage() {}
+
+func (x *GetProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type GetProfileByUsernameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileByUsernameRequest) Reset() {
+	*x = GetProfileByUsernameRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileByUsernameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileByUsernameRequest) ProtoMessage() {}
+
+func (x *GetProfileByUsernameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileByUsernameRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileByUsernameRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetProfileByUsernameRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type GetProfileByUsernameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileByUsernameResponse) Reset() {
+	*x = GetProfileByUsernameResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileByUsernameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileByUsernameResponse) ProtoMessage() {}
+
+func (x *GetProfileByUsernameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileByUsernameResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileByUsernameResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetProfileByUsernameResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type UpdateProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Bio           string                 `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	Location      string                 `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	Website       string                 `protobuf:"bytes,6,opt,name=website,proto3" json:"website,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *UpdateProfileRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+type UpdateProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileResponse) Reset() {
+	*x = UpdateProfileResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileResponse) ProtoMessage() {}
+
+func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *UpdateProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type GetConfigVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfigVersionRequest) Reset() {
+	*x = GetConfigVersionRequest{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigVersionRequest) ProtoMessage() {}
+
+func (x *GetConfigVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigVersionRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigVersionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{87}
+}
+
+// GetConfigVersionResponse версия активного конфига. version растет на каждую успешную
+// перезагрузку по SIGHUP, checksum считается по конфигу без секретов
+type GetConfigVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int64                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Checksum      string                 `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	LoadedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=loaded_at,json=loadedAt,proto3" json:"loaded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfigVersionResponse) Reset() {
+	*x = GetConfigVersionResponse{}
+	mi := &file_api_proto_v1_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigVersionResponse) ProtoMessage() {}
+
+func (x *GetConfigVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v1_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigVersionResponse.ProtoReflect.Descriptor instead.
+func (*GetConfigVersionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_v1_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetConfigVersionResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetConfigVersionResponse) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *GetConfigVersionResponse) GetLoadedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LoadedAt
+	}
+	return nil
+}
+
+var File_api_proto_v1_service_proto protoreflect.FileDescriptor
+
+const file_api_proto_v1_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1aapi/proto/v1/service.proto\x12\fapi.proto.v1\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x15google/rpc/code.proto\"\xa1\x01\n" +
+	"\x12CreateTweetRequest\x12\x1e\n" +
+	"\x04text\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xfa\x01R\x04text\x12,\n" +
+	"\x04poll\x18\x02 \x01(\v2\x18.api.proto.v1.CreatePollR\x04poll\x12=\n" +
+	"\x05media\x18\x03 \x03(\v2\x1d.api.proto.v1.MediaAttachmentB\b\xfaB\x05\x92\x01\x02\x10\x04R\x05media\"@\n" +
+	"\x13CreateTweetResponse\x12)\n" +
+	"\x05tweet\x18\x01 \x01(\v2\x13.api.proto.v1.TweetR\x05tweet\"/\n" +
+	"\x13GetTweetByIDRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\"A\n" +
+	"\x14GetTweetByIDResponse\x12)\n" +
+	"\x05tweet\x18\x01 \x01(\v2\x13.api.proto.v1.TweetR\x05tweet\"<\n" +
+	"\x15BatchGetTweetsRequest\x12#\n" +
+	"\x03ids\x18\x01 \x03(\tB\x11\xfaB\x0e\x92\x01\v\b\x01\x10d\"\x05r\x03\xb0\x01\x01R\x03ids\"V\n" +
+	"\x16BatchGetTweetsResponse\x12<\n" +
+	"\aresults\x18\x01 \x03(\v2\".api.proto.v1.BatchGetTweetsResultR\aresults\"n\n" +
+	"\x14BatchGetTweetsResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12)\n" +
+	"\x05tweet\x18\x02 \x01(\v2\x13.api.proto.v1.TweetR\x05tweet\x12\x1b\n" +
+	"\tnot_found\x18\x03 \x01(\bR\bnotFound\"\x80\x01\n" +
+	"\x14GetUserTweetsRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"l\n" +
+	"\x15GetUserTweetsResponse\x12+\n" +
+	"\x06tweets\x18\x01 \x03(\v2\x13.api.proto.v1.TweetR\x06tweets\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x82\x01\n" +
+	"\x12UpdateTweetRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x12\x1e\n" +
+	"\x04text\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xfa\x01R\x04text\x122\n" +
+	"\x10expected_version\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x0fexpectedVersion\"@\n" +
+	"\x13UpdateTweetResponse\x12)\n" +
+	"\x05tweet\x18\x01 \x01(\v2\x13.api.proto.v1.TweetR\x05tweet\".\n" +
+	"\x12DeleteTweetRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\"\x15\n" +
+	"\x13DeleteTweetResponse\"\x7f\n" +
+	"\x1bGetSubscribersTweetsRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"s\n" +
+	"\x1cGetSubscribersTweetsResponse\x12+\n" +
+	"\x06tweets\x18\x01 \x03(\v2\x13.api.proto.v1.TweetR\x06tweets\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x95\x03\n" +
+	"\x05Tweet\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x12\x1e\n" +
+	"\x04text\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xfa\x01R\x04text\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
 	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12!\n" +
-	"\auser_id\x18\x05 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId2\xbd\x05\n" +
+	"\auser_id\x18\x05 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12&\n" +
+	"\x04poll\x18\x06 \x01(\v2\x12.api.proto.v1.PollR\x04poll\x12)\n" +
+	"\x05media\x18\a \x03(\v2\x13.api.proto.v1.MediaR\x05media\x12\x1e\n" +
+	"\n" +
+	"bookmarked\x18\b \x01(\bR\n" +
+	"bookmarked\x12,\n" +
+	"\x06author\x18\t \x01(\v2\x14.api.proto.v1.AuthorR\x06author\x12\x18\n" +
+	"\aversion\x18\n" +
+	" \x01(\x03R\aversion\"\x7f\n" +
+	"\x06Author\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\"\x85\x01\n" +
+	"\n" +
+	"CreatePoll\x12,\n" +
+	"\aoptions\x18\x01 \x03(\tB\x12\xfaB\x0f\x92\x01\f\b\x02\x10\x04\"\x06r\x04\x10\x01\x18\x19R\aoptions\x12I\n" +
+	"\tcloses_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x10\xfaB\r\xb2\x01\n" +
+	"\b\x01@\x01J\x04\b\x80\xf5$R\bclosesAt\"\xac\x01\n" +
+	"\x04Poll\x122\n" +
+	"\aoptions\x18\x01 \x03(\v2\x18.api.proto.v1.PollOptionR\aoptions\x127\n" +
+	"\tcloses_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bclosesAt\x12\x16\n" +
+	"\x06closed\x18\x03 \x01(\bR\x06closed\x12\x1f\n" +
+	"\vtotal_votes\x18\x04 \x01(\x03R\n" +
+	"totalVotes\"R\n" +
+	"\n" +
+	"PollOption\x12\x1a\n" +
+	"\bposition\x18\x01 \x01(\x05R\bposition\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x14\n" +
+	"\x05votes\x18\x03 \x01(\x03R\x05votes\"]\n" +
+	"\x0fVotePollRequest\x12#\n" +
+	"\btweet_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\atweetId\x12%\n" +
+	"\bposition\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x10\x04(\x00R\bposition\":\n" +
+	"\x10VotePollResponse\x12&\n" +
+	"\x04poll\x18\x01 \x01(\v2\x12.api.proto.v1.PollR\x04poll\"f\n" +
+	"\x12UploadMediaRequest\x12-\n" +
+	"\x04info\x18\x01 \x01(\v2\x17.api.proto.v1.MediaInfoH\x00R\x04info\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"F\n" +
+	"\tMediaInfo\x129\n" +
+	"\tmime_type\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17R\n" +
+	"image/jpegR\timage/pngR\bmimeType\"@\n" +
+	"\x13UploadMediaResponse\x12)\n" +
+	"\x05media\x18\x01 \x01(\v2\x13.api.proto.v1.MediaR\x05media\"[\n" +
+	"\x0fMediaAttachment\x12#\n" +
+	"\bmedia_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\amediaId\x12#\n" +
+	"\balt_text\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xe8\aR\aaltText\"\xc8\x01\n" +
+	"\x05Media\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tmime_type\x18\x02 \x01(\tR\bmimeType\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12\x14\n" +
+	"\x05width\x18\x04 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x05 \x01(\x05R\x06height\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\x12#\n" +
+	"\rthumbnail_url\x18\a \x01(\tR\fthumbnailUrl\x12\x19\n" +
+	"\balt_text\x18\b \x01(\tR\aaltText\"c\n" +
+	"\x12AddBookmarkRequest\x12#\n" +
+	"\btweet_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\atweetId\x12(\n" +
+	"\tfolder_id\x18\x02 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\bfolderId\"\x15\n" +
+	"\x13AddBookmarkResponse\"<\n" +
+	"\x15RemoveBookmarkRequest\x12#\n" +
+	"\btweet_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\atweetId\"\x18\n" +
+	"\x16RemoveBookmarkResponse\"\x87\x01\n" +
+	"\x14ListBookmarksRequest\x12(\n" +
+	"\tfolder_id\x18\x01 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\bfolderId\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"l\n" +
+	"\x15ListBookmarksResponse\x12+\n" +
+	"\x06tweets\x18\x01 \x03(\v2\x13.api.proto.v1.TweetR\x06tweets\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"o\n" +
+	"\x0eBookmarkFolder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"<\n" +
+	"\x1bCreateBookmarkFolderRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04name\"T\n" +
+	"\x1cCreateBookmarkFolderResponse\x124\n" +
+	"\x06folder\x18\x01 \x01(\v2\x1c.api.proto.v1.BookmarkFolderR\x06folder\"\x1c\n" +
+	"\x1aListBookmarkFoldersRequest\"U\n" +
+	"\x1bListBookmarkFoldersResponse\x126\n" +
+	"\afolders\x18\x01 \x03(\v2\x1c.api.proto.v1.BookmarkFolderR\afolders\"V\n" +
+	"\x1bRenameBookmarkFolderRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04name\"T\n" +
+	"\x1cRenameBookmarkFolderResponse\x124\n" +
+	"\x06folder\x18\x01 \x01(\v2\x1c.api.proto.v1.BookmarkFolderR\x06folder\"7\n" +
+	"\x1bDeleteBookmarkFolderRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\"\x1e\n" +
+	"\x1cDeleteBookmarkFolderResponse\"\x9a\x02\n" +
+	"\x04List\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x18\n" +
+	"\aprivate\x18\x05 \x01(\bR\aprivate\x12!\n" +
+	"\fmember_count\x18\x06 \x01(\x05R\vmemberCount\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"w\n" +
+	"\x11CreateListRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18\x19R\x04name\x12)\n" +
+	"\vdescription\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x18dR\vdescription\x12\x18\n" +
+	"\aprivate\x18\x03 \x01(\bR\aprivate\"<\n" +
+	"\x12CreateListResponse\x12&\n" +
+	"\x04list\x18\x01 \x01(\v2\x12.api.proto.v1.ListR\x04list\"*\n" +
+	"\x0eGetListRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\"9\n" +
+	"\x0fGetListResponse\x12&\n" +
+	"\x04list\x18\x01 \x01(\v2\x12.api.proto.v1.ListR\x04list\"\x91\x01\n" +
+	"\x11UpdateListRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18\x19R\x04name\x12)\n" +
+	"\vdescription\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18dR\vdescription\x12\x18\n" +
+	"\aprivate\x18\x04 \x01(\bR\aprivate\"<\n" +
+	"\x12UpdateListResponse\x12&\n" +
+	"\x04list\x18\x01 \x01(\v2\x12.api.proto.v1.ListR\x04list\"-\n" +
+	"\x11DeleteListRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\"\x14\n" +
+	"\x12DeleteListResponse\"8\n" +
+	"\x13GetUserListsRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\"@\n" +
+	"\x14GetUserListsResponse\x12(\n" +
+	"\x05lists\x18\x01 \x03(\v2\x12.api.proto.v1.ListR\x05lists\"\\\n" +
+	"\x14AddListMemberRequest\x12!\n" +
+	"\alist_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06listId\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\"\x17\n" +
+	"\x15AddListMemberResponse\"_\n" +
+	"\x17RemoveListMemberRequest\x12!\n" +
+	"\alist_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06listId\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\"\x1a\n" +
+	"\x18RemoveListMemberResponse\":\n" +
+	"\x15GetListMembersRequest\x12!\n" +
+	"\alist_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06listId\"3\n" +
+	"\x16GetListMembersResponse\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\"\x82\x01\n" +
+	"\x16GetListTimelineRequest\x12!\n" +
+	"\alist_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06listId\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"n\n" +
+	"\x17GetListTimelineResponse\x12+\n" +
+	"\x06tweets\x18\x01 \x03(\v2\x13.api.proto.v1.TweetR\x06tweets\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"1\n" +
+	"\fBlockRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\"\x0f\n" +
+	"\rBlockResponse\"3\n" +
+	"\x0eUnblockRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\"\x11\n" +
+	"\x0fUnblockResponse\"0\n" +
+	"\vMuteRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\"\x0e\n" +
+	"\fMuteResponse\"2\n" +
+	"\rUnmuteRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\"\x10\n" +
+	"\x0eUnmuteResponse\"\x9b\x01\n" +
+	"\rAccessRequest\x12\x1b\n" +
+	"\treader_id\x18\x01 \x01(\tR\breaderId\x122\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1a.api.proto.v1.AccessStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"3\n" +
+	"\x13SetProtectedRequest\x12\x1c\n" +
+	"\tprotected\x18\x01 \x01(\bR\tprotected\"4\n" +
+	"\x14SetProtectedResponse\x12\x1c\n" +
+	"\tprotected\x18\x01 \x01(\bR\tprotected\"9\n" +
+	"\x14RequestAccessRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\"K\n" +
+	"\x15RequestAccessResponse\x122\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1a.api.proto.v1.AccessStatusR\x06status\"\x1b\n" +
+	"\x19ListAccessRequestsRequest\"U\n" +
+	"\x1aListAccessRequestsResponse\x127\n" +
+	"\brequests\x18\x01 \x03(\v2\x1b.api.proto.v1.AccessRequestR\brequests\"D\n" +
+	"\x1bApproveAccessRequestRequest\x12%\n" +
+	"\treader_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\breaderId\"\x1e\n" +
+	"\x1cApproveAccessRequestResponse\"<\n" +
+	"\x13RevokeAccessRequest\x12%\n" +
+	"\treader_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\breaderId\"\x16\n" +
+	"\x14RevokeAccessResponse\"\xbe\x02\n" +
+	"\aProfile\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12\x10\n" +
+	"\x03bio\x18\x04 \x01(\tR\x03bio\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x05 \x01(\tR\tavatarUrl\x12\x1a\n" +
+	"\blocation\x18\x06 \x01(\tR\blocation\x12\x18\n" +
+	"\awebsite\x18\a \x01(\tR\awebsite\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"6\n" +
+	"\x11GetProfileRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\"E\n" +
+	"\x12GetProfileResponse\x12/\n" +
+	"\aprofile\x18\x01 \x01(\v2\x15.api.proto.v1.ProfileR\aprofile\"V\n" +
+	"\x1bGetProfileByUsernameRequest\x127\n" +
+	"\busername\x18\x01 \x01(\tB\x1b\xfaB\x18r\x162\x14^[A-Za-z0-9_]{1,15}$R\busername\"O\n" +
+	"\x1cGetProfileByUsernameResponse\x12/\n" +
+	"\aprofile\x18\x01 \x01(\v2\x15.api.proto.v1.ProfileR\aprofile\"\xac\x02\n" +
+	"\x14UpdateProfileRequest\x127\n" +
+	"\busername\x18\x01 \x01(\tB\x1b\xfaB\x18r\x162\x14^[A-Za-z0-9_]{1,15}$R\busername\x12*\n" +
+	"\fdisplay_name\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x182R\vdisplayName\x12\x1a\n" +
+	"\x03bio\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xa0\x01R\x03bio\x129\n" +
+	"\n" +
+	"avatar_url\x18\x04 \x01(\tB\x1a\xfaB\x17r\x15\x18\xc8\x012\n" +
+	"^https?://\xd0\x01\x01\x88\x01\x01R\tavatarUrl\x12#\n" +
+	"\blocation\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x18\x1eR\blocation\x123\n" +
+	"\awebsite\x18\x06 \x01(\tB\x19\xfaB\x16r\x14\x18d2\n" +
+	"^https?://\xd0\x01\x01\x88\x01\x01R\awebsite\"H\n" +
+	"\x15UpdateProfileResponse\x12/\n" +
+	"\aprofile\x18\x01 \x01(\v2\x15.api.proto.v1.ProfileR\aprofile\"\x19\n" +
+	"\x17GetConfigVersionRequest\"\x89\x01\n" +
+	"\x18GetConfigVersionResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\x12\x1a\n" +
+	"\bchecksum\x18\x02 \x01(\tR\bchecksum\x127\n" +
+	"\tloaded_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bloadedAt*]\n" +
+	"\fAccessStatus\x12\x16\n" +
+	"\x12ACCESS_STATUS_NONE\x10\x00\x12\x19\n" +
+	"\x15ACCESS_STATUS_PENDING\x10\x01\x12\x1a\n" +
+	"\x16ACCESS_STATUS_APPROVED\x10\x022\xce#\n" +
 	"\n" +
 	"TwitterAPI\x12f\n" +
 	"\vCreateTweet\x12 .api.proto.v1.CreateTweetRequest\x1a!.api.proto.v1.CreateTweetResponse\"\x12\x82\xd3\xe4\x93\x02\f:\x01*\"\a/tweets\x12k\n" +
-	"\fGetTweetByID\x12!.api.proto.v1.GetTweetByIDRequest\x1a\".api.proto.v1.GetTweetByIDResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/tweets/{id}\x12y\n" +
+	"\fGetTweetByID\x12!.api.proto.v1.GetTweetByIDRequest\x1a\".api.proto.v1.GetTweetByIDResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/tweets/{id}\x12u\n" +
+	"\x0eBatchGetTweets\x12#.api.proto.v1.BatchGetTweetsRequest\x1a$.api.proto.v1.BatchGetTweetsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/tweets:batchGet\x12y\n" +
 	"\rGetUserTweets\x12\".api.proto.v1.GetUserTweetsRequest\x1a#.api.proto.v1.GetUserTweetsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/users/{user_id}/tweets\x12k\n" +
 	"\vUpdateTweet\x12 .api.proto.v1.UpdateTweetRequest\x1a!.api.proto.v1.UpdateTweetResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\x1a\f/tweets/{id}\x12h\n" +
 	"\vDeleteTweet\x12 .api.proto.v1.DeleteTweetRequest\x1a!.api.proto.v1.DeleteTweetResponse\"\x14\x82\xd3\xe4\x93\x02\x0e*\f/tweets/{id}\x12\x87\x01\n" +
-	"\x14GetSubscribersTweets\x12).api.proto.v1.GetSubscribersTweetsRequest\x1a*.api.proto.v1.GetSubscribersTweetsResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/tweets/usersB\x06Z\x04.;pbb\x06proto3"
+	"\x14GetSubscribersTweets\x12).api.proto.v1.GetSubscribersTweetsRequest\x1a*.api.proto.v1.GetSubscribersTweetsResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/tweets/users\x12T\n" +
+	"\vUploadMedia\x12 .api.proto.v1.UploadMediaRequest\x1a!.api.proto.v1.UploadMediaResponse(\x01\x12s\n" +
+	"\bVotePoll\x12\x1d.api.proto.v1.VotePollRequest\x1a\x1e.api.proto.v1.VotePollResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/tweets/{tweet_id}/poll/votes\x12i\n" +
+	"\vAddBookmark\x12 .api.proto.v1.AddBookmarkRequest\x1a!.api.proto.v1.AddBookmarkResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/bookmarks\x12z\n" +
+	"\x0eRemoveBookmark\x12#.api.proto.v1.RemoveBookmarkRequest\x1a$.api.proto.v1.RemoveBookmarkResponse\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/bookmarks/{tweet_id}\x12l\n" +
+	"\rListBookmarks\x12\".api.proto.v1.ListBookmarksRequest\x1a#.api.proto.v1.ListBookmarksResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/bookmarks\x12\x8c\x01\n" +
+	"\x14CreateBookmarkFolder\x12).api.proto.v1.CreateBookmarkFolderRequest\x1a*.api.proto.v1.CreateBookmarkFolderResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/bookmarks/folders\x12\x86\x01\n" +
+	"\x13ListBookmarkFolders\x12(.api.proto.v1.ListBookmarkFoldersRequest\x1a).api.proto.v1.ListBookmarkFoldersResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/bookmarks/folders\x12\x91\x01\n" +
+	"\x14RenameBookmarkFolder\x12).api.proto.v1.RenameBookmarkFolderRequest\x1a*.api.proto.v1.RenameBookmarkFolderResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*2\x17/bookmarks/folders/{id}\x12\x8e\x01\n" +
+	"\x14DeleteBookmarkFolder\x12).api.proto.v1.DeleteBookmarkFolderRequest\x1a*.api.proto.v1.DeleteBookmarkFolderResponse\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/bookmarks/folders/{id}\x12b\n" +
+	"\n" +
+	"CreateList\x12\x1f.api.proto.v1.CreateListRequest\x1a .api.proto.v1.CreateListResponse\"\x11\x82\xd3\xe4\x93\x02\v:\x01*\"\x06/lists\x12[\n" +
+	"\aGetList\x12\x1c.api.proto.v1.GetListRequest\x1a\x1d.api.proto.v1.GetListResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/lists/{id}\x12g\n" +
+	"\n" +
+	"UpdateList\x12\x1f.api.proto.v1.UpdateListRequest\x1a .api.proto.v1.UpdateListResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*2\v/lists/{id}\x12d\n" +
+	"\n" +
+	"DeleteList\x12\x1f.api.proto.v1.DeleteListRequest\x1a .api.proto.v1.DeleteListResponse\"\x13\x82\xd3\xe4\x93\x02\r*\v/lists/{id}\x12u\n" +
+	"\fGetUserLists\x12!.api.proto.v1.GetUserListsRequest\x1a\".api.proto.v1.GetUserListsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/users/{user_id}/lists\x12}\n" +
+	"\rAddListMember\x12\".api.proto.v1.AddListMemberRequest\x1a#.api.proto.v1.AddListMemberResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/lists/{list_id}/members\x12\x8d\x01\n" +
+	"\x10RemoveListMember\x12%.api.proto.v1.RemoveListMemberRequest\x1a&.api.proto.v1.RemoveListMemberResponse\"*\x82\xd3\xe4\x93\x02$*\"/lists/{list_id}/members/{user_id}\x12}\n" +
+	"\x0eGetListMembers\x12#.api.proto.v1.GetListMembersRequest\x1a$.api.proto.v1.GetListMembersResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/lists/{list_id}/members\x12\x7f\n" +
+	"\x0fGetListTimeline\x12$.api.proto.v1.GetListTimelineRequest\x1a%.api.proto.v1.GetListTimelineResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/lists/{list_id}/tweets\x12`\n" +
+	"\x05Block\x12\x1a.api.proto.v1.BlockRequest\x1a\x1b.api.proto.v1.BlockResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\"\x16/users/{user_id}/block\x12f\n" +
+	"\aUnblock\x12\x1c.api.proto.v1.UnblockRequest\x1a\x1d.api.proto.v1.UnblockResponse\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/users/{user_id}/block\x12\\\n" +
+	"\x04Mute\x12\x19.api.proto.v1.MuteRequest\x1a\x1a.api.proto.v1.MuteResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\"\x15/users/{user_id}/mute\x12b\n" +
+	"\x06Unmute\x12\x1b.api.proto.v1.UnmuteRequest\x1a\x1c.api.proto.v1.UnmuteResponse\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/users/{user_id}/mute\x12t\n" +
+	"\fSetProtected\x12!.api.proto.v1.SetProtectedRequest\x1a\".api.proto.v1.SetProtectedResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\x1a\x12/account/protected\x12y\n" +
+	"\rRequestAccess\x12\".api.proto.v1.RequestAccessRequest\x1a#.api.proto.v1.RequestAccessResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\"\x17/users/{user_id}/access\x12\x89\x01\n" +
+	"\x12ListAccessRequests\x12'.api.proto.v1.ListAccessRequestsRequest\x1a(.api.proto.v1.ListAccessRequestsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/account/access-requests\x12\xa3\x01\n" +
+	"\x14ApproveAccessRequest\x12).api.proto.v1.ApproveAccessRequestRequest\x1a*.api.proto.v1.ApproveAccessRequestResponse\"4\x82\xd3\xe4\x93\x02.\",/account/access-requests/{reader_id}/approve\x12{\n" +
+	"\fRevokeAccess\x12!.api.proto.v1.RevokeAccessRequest\x1a\".api.proto.v1.RevokeAccessResponse\"$\x82\xd3\xe4\x93\x02\x1e*\x1c/account/readers/{reader_id}\x12q\n" +
+	"\n" +
+	"GetProfile\x12\x1f.api.proto.v1.GetProfileRequest\x1a .api.proto.v1.GetProfileResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/users/{user_id}/profile\x12\x8b\x01\n" +
+	"\x14GetProfileByUsername\x12).api.proto.v1.GetProfileByUsernameRequest\x1a*.api.proto.v1.GetProfileByUsernameResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/profiles/{username}\x12u\n" +
+	"\rUpdateProfile\x12\".api.proto.v1.UpdateProfileRequest\x1a#.api.proto.v1.UpdateProfileResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/account/profile\x12\x80\x01\n" +
+	"\x10GetConfigVersion\x12%.api.proto.v1.GetConfigVersionRequest\x1a&.api.proto.v1.GetConfigVersionResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/admin/config/versionB\x06Z\x04.;pbb\x06proto3"
 
 var (
 	file_api_proto_v1_service_proto_rawDescOnce sync.Once
@@ -690,48 +4887,225 @@ func file_api_proto_v1_service_proto_rawDescGZIP() []byte {
 	return file_api_proto_v1_service_proto_rawDescData
 }
 
-var file_api_proto_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_api_proto_v1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_proto_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 89)
 var file_api_proto_v1_service_proto_goTypes = []any{
-	(*CreateTweetRequest)(nil),           // 0: api.proto.v1.CreateTweetRequest
-	(*CreateTweetResponse)(nil),          // 1: api.proto.v1.CreateTweetResponse
-	(*GetTweetByIDRequest)(nil),          // 2: api.proto.v1.GetTweetByIDRequest
-	(*GetTweetByIDResponse)(nil),         // 3: api.proto.v1.GetTweetByIDResponse
-	(*GetUserTweetsRequest)(nil),         // 4: api.proto.v1.GetUserTweetsRequest
-	(*GetUserTweetsResponse)(nil),        // 5: api.proto.v1.GetUserTweetsResponse
-	(*UpdateTweetRequest)(nil),           // 6: api.proto.v1.UpdateTweetRequest
-	(*UpdateTweetResponse)(nil),          // 7: api.proto.v1.UpdateTweetResponse
-	(*DeleteTweetRequest)(nil),           // 8: api.proto.v1.DeleteTweetRequest
-	(*DeleteTweetResponse)(nil),          // 9: api.proto.v1.DeleteTweetResponse
-	(*GetSubscribersTweetsRequest)(nil),  // 10: api.proto.v1.GetSubscribersTweetsRequest
-	(*GetSubscribersTweetsResponse)(nil), // 11: api.proto.v1.GetSubscribersTweetsResponse
-	(*Tweet)(nil),                        // 12: api.proto.v1.Tweet
-	(*timestamppb.Timestamp)(nil),        // 13: google.protobuf.Timestamp
+	(AccessStatus)(0),                    // 0: api.proto.v1.AccessStatus
+	(*CreateTweetRequest)(nil),           // 1: api.proto.v1.CreateTweetRequest
+	(*CreateTweetResponse)(nil),          // 2: api.proto.v1.CreateTweetResponse
+	(*GetTweetByIDRequest)(nil),          // 3: api.proto.v1.GetTweetByIDRequest
+	(*GetTweetByIDResponse)(nil),         // 4: api.proto.v1.GetTweetByIDResponse
+	(*BatchGetTweetsRequest)(nil),        // 5: api.proto.v1.BatchGetTweetsRequest
+	(*BatchGetTweetsResponse)(nil),       // 6: api.proto.v1.BatchGetTweetsResponse
+	(*BatchGetTweetsResult)(nil),         // 7: api.proto.v1.BatchGetTweetsResult
+	(*GetUserTweetsRequest)(nil),         // 8: api.proto.v1.GetUserTweetsRequest
+	(*GetUserTweetsResponse)(nil),        // 9: api.proto.v1.GetUserTweetsResponse
+	(*UpdateTweetRequest)(nil),           // 10: api.proto.v1.UpdateTweetRequest
+	(*UpdateTweetResponse)(nil),          // 11: api.proto.v1.UpdateTweetResponse
+	(*DeleteTweetRequest)(nil),           // 12: api.proto.v1.DeleteTweetRequest
+	(*DeleteTweetResponse)(nil),          // 13: api.proto.v1.DeleteTweetResponse
+	(*GetSubscribersTweetsRequest)(nil),  // 14: api.proto.v1.GetSubscribersTweetsRequest
+	(*GetSubscribersTweetsResponse)(nil), // 15: api.proto.v1.GetSubscribersTweetsResponse
+	(*Tweet)(nil),                        // 16: api.proto.v1.Tweet
+	(*Author)(nil),                       // 17: api.proto.v1.Author
+	(*CreatePoll)(nil),                   // 18: api.proto.v1.CreatePoll
+	(*Poll)(nil),                         // 19: api.proto.v1.Poll
+	(*PollOption)(nil),                   // 20: api.proto.v1.PollOption
+	(*VotePollRequest)(nil),              // 21: api.proto.v1.VotePollRequest
+	(*VotePollResponse)(nil),             // 22: api.proto.v1.VotePollResponse
+	(*UploadMediaRequest)(nil),           // 23: api.proto.v1.UploadMediaRequest
+	(*MediaInfo)(nil),                    // 24: api.proto.v1.MediaInfo
+	(*UploadMediaResponse)(nil),          // 25: api.proto.v1.UploadMediaResponse
+	(*MediaAttachment)(nil),              // 26: api.proto.v1.MediaAttachment
+	(*Media)(nil),                        // 27: api.proto.v1.Media
+	(*AddBookmarkRequest)(nil),           // 28: api.proto.v1.AddBookmarkRequest
+	(*AddBookmarkResponse)(nil),          // 29: api.proto.v1.AddBookmarkResponse
+	(*RemoveBookmarkRequest)(nil),        // 30: api.proto.v1.RemoveBookmarkRequest
+	(*RemoveBookmarkResponse)(nil),       // 31: api.proto.v1.RemoveBookmarkResponse
+	(*ListBookmarksRequest)(nil),         // 32: api.proto.v1.ListBookmarksRequest
+	(*ListBookmarksResponse)(nil),        // 33: api.proto.v1.ListBookmarksResponse
+	(*BookmarkFolder)(nil),               // 34: api.proto.v1.BookmarkFolder
+	(*CreateBookmarkFolderRequest)(nil),  // 35: api.proto.v1.CreateBookmarkFolderRequest
+	(*CreateBookmarkFolderResponse)(nil), // 36: api.proto.v1.CreateBookmarkFolderResponse
+	(*ListBookmarkFoldersRequest)(nil),   // 37: api.proto.v1.ListBookmarkFoldersRequest
+	(*ListBookmarkFoldersResponse)(nil),  // 38: api.proto.v1.ListBookmarkFoldersResponse
+	(*RenameBookmarkFolderRequest)(nil),  // 39: api.proto.v1.RenameBookmarkFolderRequest
+	(*RenameBookmarkFolderResponse)(nil), // 40: api.proto.v1.RenameBookmarkFolderResponse
+	(*DeleteBookmarkFolderRequest)(nil),  // 41: api.proto.v1.DeleteBookmarkFolderRequest
+	(*DeleteBookmarkFolderResponse)(nil), // 42: api.proto.v1.DeleteBookmarkFolderResponse
+	(*List)(nil),                         // 43: api.proto.v1.List
+	(*CreateListRequest)(nil),            // 44: api.proto.v1.CreateListRequest
+	(*CreateListResponse)(nil),           // 45: api.proto.v1.CreateListResponse
+	(*GetListRequest)(nil),               // 46: api.proto.v1.GetListRequest
+	(*GetListResponse)(nil),              // 47: api.proto.v1.GetListResponse
+	(*UpdateListRequest)(nil),            // 48: api.proto.v1.UpdateListRequest
+	(*UpdateListResponse)(nil),           // 49: api.proto.v1.UpdateListResponse
+	(*DeleteListRequest)(nil),            // 50: api.proto.v1.DeleteListRequest
+	(*DeleteListResponse)(nil),           // 51: api.proto.v1.DeleteListResponse
+	(*GetUserListsRequest)(nil),          // 52: api.proto.v1.GetUserListsRequest
+	(*GetUserListsResponse)(nil),         // 53: api.proto.v1.GetUserListsResponse
+	(*AddListMemberRequest)(nil),         // 54: api.proto.v1.AddListMemberRequest
+	(*AddListMemberResponse)(nil),        // 55: api.proto.v1.AddListMemberResponse
+	(*RemoveListMemberRequest)(nil),      // 56: api.proto.v1.RemoveListMemberRequest
+	(*RemoveListMemberResponse)(nil),     // 57: api.proto.v1.RemoveListMemberResponse
+	(*GetListMembersRequest)(nil),        // 58: api.proto.v1.GetListMembersRequest
+	(*GetListMembersResponse)(nil),       // 59: api.proto.v1.GetListMembersResponse
+	(*GetListTimelineRequest)(nil),       // 60: api.proto.v1.GetListTimelineRequest
+	(*GetListTimelineResponse)(nil),      // 61: api.proto.v1.GetListTimelineResponse
+	(*BlockRequest)(nil),                 // 62: api.proto.v1.BlockRequest
+	(*BlockResponse)(nil),                // 63: api.proto.v1.BlockResponse
+	(*UnblockRequest)(nil),               // 64: api.proto.v1.UnblockRequest
+	(*UnblockResponse)(nil),              // 65: api.proto.v1.UnblockResponse
+	(*MuteRequest)(nil),                  // 66: api.proto.v1.MuteRequest
+	(*MuteResponse)(nil),                 // 67: api.proto.v1.MuteResponse
+	(*UnmuteRequest)(nil),                // 68: api.proto.v1.UnmuteRequest
+	(*UnmuteResponse)(nil),               // 69: api.proto.v1.UnmuteResponse
+	(*AccessRequest)(nil),                // 70: api.proto.v1.AccessRequest
+	(*SetProtectedRequest)(nil),          // 71: api.proto.v1.SetProtectedRequest
+	(*SetProtectedResponse)(nil),         // 72: api.proto.v1.SetProtectedResponse
+	(*RequestAccessRequest)(nil),         // 73: api.proto.v1.RequestAccessRequest
+	(*RequestAccessResponse)(nil),        // 74: api.proto.v1.RequestAccessResponse
+	(*ListAccessRequestsRequest)(nil),    // 75: api.proto.v1.ListAccessRequestsRequest
+	(*ListAccessRequestsResponse)(nil),   // 76: api.proto.v1.ListAccessRequestsResponse
+	(*ApproveAccessRequestRequest)(nil),  // 77: api.proto.v1.ApproveAccessRequestRequest
+	(*ApproveAccessRequestResponse)(nil), // 78: api.proto.v1.ApproveAccessRequestResponse
+	(*RevokeAccessRequest)(nil),          // 79: api.proto.v1.RevokeAccessRequest
+	(*RevokeAccessResponse)(nil),         // 80: api.proto.v1.RevokeAccessResponse
+	(*Profile)(nil),                      // 81: api.proto.v1.Profile
+	(*GetProfileRequest)(nil),            // 82: api.proto.v1.GetProfileRequest
+	(*GetProfileResponse)(nil),           // 83: api.proto.v1.GetProfileResponse
+	(*GetProfileByUsernameRequest)(nil),  // 84: api.proto.v1.GetProfileByUsernameRequest
+	(*GetProfileByUsernameResponse)(nil), // 85: api.proto.v1.GetProfileByUsernameResponse
+	(*UpdateProfileRequest)(nil),         // 86: api.proto.v1.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),        // 87: api.proto.v1.UpdateProfileResponse
+	(*GetConfigVersionRequest)(nil),      // 88: api.proto.v1.GetConfigVersionRequest
+	(*GetConfigVersionResponse)(nil),     // 89: api.proto.v1.GetConfigVersionResponse
+	(*timestamppb.Timestamp)(nil),        // 90: google.protobuf.Timestamp
 }
 var file_api_proto_v1_service_proto_depIdxs = []int32{
-	12, // 0: api.proto.v1.CreateTweetResponse.tweet:type_name -> api.proto.v1.Tweet
-	12, // 1: api.proto.v1.GetTweetByIDResponse.tweet:type_name -> api.proto.v1.Tweet
-	12, // 2: api.proto.v1.GetUserTweetsResponse.tweets:type_name -> api.proto.v1.Tweet
-	12, // 3: api.proto.v1.UpdateTweetResponse.tweet:type_name -> api.proto.v1.Tweet
-	12, // 4: api.proto.v1.GetSubscribersTweetsResponse.tweets:type_name -> api.proto.v1.Tweet
-	13, // 5: api.proto.v1.Tweet.created_at:type_name -> google.protobuf.Timestamp
-	13, // 6: api.proto.v1.Tweet.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 7: api.proto.v1.TwitterAPI.CreateTweet:input_type -> api.proto.v1.CreateTweetRequest
-	2,  // 8: api.proto.v1.TwitterAPI.GetTweetByID:input_type -> api.proto.v1.GetTweetByIDRequest
-	4,  // 9: api.proto.v1.TwitterAPI.GetUserTweets:input_type -> api.proto.v1.GetUserTweetsRequest
-	6,  // 10: api.proto.v1.TwitterAPI.UpdateTweet:input_type -> api.proto.v1.UpdateTweetRequest
-	8,  // 11: api.proto.v1.TwitterAPI.DeleteTweet:input_type -> api.proto.v1.DeleteTweetRequest
-	10, // 12: api.proto.v1.TwitterAPI.GetSubscribersTweets:input_type -> api.proto.v1.GetSubscribersTweetsRequest
-	1,  // 13: api.proto.v1.TwitterAPI.CreateTweet:output_type -> api.proto.v1.CreateTweetResponse
-	3,  // 14: api.proto.v1.TwitterAPI.GetTweetByID:output_type -> api.proto.v1.GetTweetByIDResponse
-	5,  // 15: api.proto.v1.TwitterAPI.GetUserTweets:output_type -> api.proto.v1.GetUserTweetsResponse
-	7,  // 16: api.proto.v1.TwitterAPI.UpdateTweet:output_type -> api.proto.v1.UpdateTweetResponse
-	9,  // 17: api.proto.v1.TwitterAPI.DeleteTweet:output_type -> api.proto.v1.DeleteTweetResponse
-	11, // 18: api.proto.v1.TwitterAPI.GetSubscribersTweets:output_type -> api.proto.v1.GetSubscribersTweetsResponse
-	13, // [13:19] is the sub-list for method output_type
-	7,  // [7:13] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	18, // 0: api.proto.v1.CreateTweetRequest.poll:type_name -> api.proto.v1.CreatePoll
+	26, // 1: api.proto.v1.CreateTweetRequest.media:type_name -> api.proto.v1.MediaAttachment
+	16, // 2: api.proto.v1.CreateTweetResponse.tweet:type_name -> api.proto.v1.Tweet
+	16, // 3: api.proto.v1.GetTweetByIDResponse.tweet:type_name -> api.proto.v1.Tweet
+	7,  // 4: api.proto.v1.BatchGetTweetsResponse.results:type_name -> api.proto.v1.BatchGetTweetsResult
+	16, // 5: api.proto.v1.BatchGetTweetsResult.tweet:type_name -> api.proto.v1.Tweet
+	16, // 6: api.proto.v1.GetUserTweetsResponse.tweets:type_name -> api.proto.v1.Tweet
+	16, // 7: api.proto.v1.UpdateTweetResponse.tweet:type_name -> api.proto.v1.Tweet
+	16, // 8: api.proto.v1.GetSubscribersTweetsResponse.tweets:type_name -> api.proto.v1.Tweet
+	90, // 9: api.proto.v1.Tweet.created_at:type_name -> google.protobuf.Timestamp
+	90, // 10: api.proto.v1.Tweet.updated_at:type_name -> google.protobuf.Timestamp
+	19, // 11: api.proto.v1.Tweet.poll:type_name -> api.proto.v1.Poll
+	27, // 12: api.proto.v1.Tweet.media:type_name -> api.proto.v1.Media
+	17, // 13: api.proto.v1.Tweet.author:type_name -> api.proto.v1.Author
+	90, // 14: api.proto.v1.CreatePoll.closes_at:type_name -> google.protobuf.Timestamp
+	20, // 15: api.proto.v1.Poll.options:type_name -> api.proto.v1.PollOption
+	90, // 16: api.proto.v1.Poll.closes_at:type_name -> google.protobuf.Timestamp
+	19, // 17: api.proto.v1.VotePollResponse.poll:type_name -> api.proto.v1.Poll
+	24, // 18: api.proto.v1.UploadMediaRequest.info:type_name -> api.proto.v1.MediaInfo
+	27, // 19: api.proto.v1.UploadMediaResponse.media:type_name -> api.proto.v1.Media
+	16, // 20: api.proto.v1.ListBookmarksResponse.tweets:type_name -> api.proto.v1.Tweet
+	90, // 21: api.proto.v1.BookmarkFolder.created_at:type_name -> google.protobuf.Timestamp
+	34, // 22: api.proto.v1.CreateBookmarkFolderResponse.folder:type_name -> api.proto.v1.BookmarkFolder
+	34, // 23: api.proto.v1.ListBookmarkFoldersResponse.folders:type_name -> api.proto.v1.BookmarkFolder
+	34, // 24: api.proto.v1.RenameBookmarkFolderResponse.folder:type_name -> api.proto.v1.BookmarkFolder
+	90, // 25: api.proto.v1.List.created_at:type_name -> google.protobuf.Timestamp
+	90, // 26: api.proto.v1.List.updated_at:type_name -> google.protobuf.Timestamp
+	43, // 27: api.proto.v1.CreateListResponse.list:type_name -> api.proto.v1.List
+	43, // 28: api.proto.v1.GetListResponse.list:type_name -> api.proto.v1.List
+	43, // 29: api.proto.v1.UpdateListResponse.list:type_name -> api.proto.v1.List
+	43, // 30: api.proto.v1.GetUserListsResponse.lists:type_name -> api.proto.v1.List
+	16, // 31: api.proto.v1.GetListTimelineResponse.tweets:type_name -> api.proto.v1.Tweet
+	0,  // 32: api.proto.v1.AccessRequest.status:type_name -> api.proto.v1.AccessStatus
+	90, // 33: api.proto.v1.AccessRequest.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 34: api.proto.v1.RequestAccessResponse.status:type_name -> api.proto.v1.AccessStatus
+	70, // 35: api.proto.v1.ListAccessRequestsResponse.requests:type_name -> api.proto.v1.AccessRequest
+	90, // 36: api.proto.v1.Profile.created_at:type_name -> google.protobuf.Timestamp
+	90, // 37: api.proto.v1.Profile.updated_at:type_name -> google.protobuf.Timestamp
+	81, // 38: api.proto.v1.GetProfileResponse.profile:type_name -> api.proto.v1.Profile
+	81, // 39: api.proto.v1.GetProfileByUsernameResponse.profile:type_name -> api.proto.v1.Profile
+	81, // 40: api.proto.v1.UpdateProfileResponse.profile:type_name -> api.proto.v1.Profile
+	90, // 41: api.proto.v1.GetConfigVersionResponse.loaded_at:type_name -> google.protobuf.Timestamp
+	1,  // 42: api.proto.v1.TwitterAPI.CreateTweet:input_type -> api.proto.v1.CreateTweetRequest
+	3,  // 43: api.proto.v1.TwitterAPI.GetTweetByID:input_type -> api.proto.v1.GetTweetByIDRequest
+	5,  // 44: api.proto.v1.TwitterAPI.BatchGetTweets:input_type -> api.proto.v1.BatchGetTweetsRequest
+	8,  // 45: api.proto.v1.TwitterAPI.GetUserTweets:input_type -> api.proto.v1.GetUserTweetsRequest
+	10, // 46: api.proto.v1.TwitterAPI.UpdateTweet:input_type -> api.proto.v1.UpdateTweetRequest
+	12, // 47: api.proto.v1.TwitterAPI.DeleteTweet:input_type -> api.proto.v1.DeleteTweetRequest
+	14, // 48: api.proto.v1.TwitterAPI.GetSubscribersTweets:input_type -> api.proto.v1.GetSubscribersTweetsRequest
+	23, // 49: api.proto.v1.TwitterAPI.UploadMedia:input_type -> api.proto.v1.UploadMediaRequest
+	21, // 50: api.proto.v1.TwitterAPI.VotePoll:input_type -> api.proto.v1.VotePollRequest
+	28, // 51: api.proto.v1.TwitterAPI.AddBookmark:input_type -> api.proto.v1.AddBookmarkRequest
+	30, // 52: api.proto.v1.TwitterAPI.RemoveBookmark:input_type -> api.proto.v1.RemoveBookmarkRequest
+	32, // 53: api.proto.v1.TwitterAPI.ListBookmarks:input_type -> api.proto.v1.ListBookmarksRequest
+	35, // 54: api.proto.v1.TwitterAPI.CreateBookmarkFolder:input_type -> api.proto.v1.CreateBookmarkFolderRequest
+	37, // 55: api.proto.v1.TwitterAPI.ListBookmarkFolders:input_type -> api.proto.v1.ListBookmarkFoldersRequest
+	39, // 56: api.proto.v1.TwitterAPI.RenameBookmarkFolder:input_type -> api.proto.v1.RenameBookmarkFolderRequest
+	41, // 57: api.proto.v1.TwitterAPI.DeleteBookmarkFolder:input_type -> api.proto.v1.DeleteBookmarkFolderRequest
+	44, // 58: api.proto.v1.TwitterAPI.CreateList:input_type -> api.proto.v1.CreateListRequest
+	46, // 59: api.proto.v1.TwitterAPI.GetList:input_type -> api.proto.v1.GetListRequest
+	48, // 60: api.proto.v1.TwitterAPI.UpdateList:input_type -> api.proto.v1.UpdateListRequest
+	50, // 61: api.proto.v1.TwitterAPI.DeleteList:input_type -> api.proto.v1.DeleteListRequest
+	52, // 62: api.proto.v1.TwitterAPI.GetUserLists:input_type -> api.proto.v1.GetUserListsRequest
+	54, // 63: api.proto.v1.TwitterAPI.AddListMember:input_type -> api.proto.v1.AddListMemberRequest
+	56, // 64: api.proto.v1.TwitterAPI.RemoveListMember:input_type -> api.proto.v1.RemoveListMemberRequest
+	58, // 65: api.proto.v1.TwitterAPI.GetListMembers:input_type -> api.proto.v1.GetListMembersRequest
+	60, // 66: api.proto.v1.TwitterAPI.GetListTimeline:input_type -> api.proto.v1.GetListTimelineRequest
+	62, // 67: api.proto.v1.TwitterAPI.Block:input_type -> api.proto.v1.BlockRequest
+	64, // 68: api.proto.v1.TwitterAPI.Unblock:input_type -> api.proto.v1.UnblockRequest
+	66, // 69: api.proto.v1.TwitterAPI.Mute:input_type -> api.proto.v1.MuteRequest
+	68, // 70: api.proto.v1.TwitterAPI.Unmute:input_type -> api.proto.v1.UnmuteRequest
+	71, // 71: api.proto.v1.TwitterAPI.SetProtected:input_type -> api.proto.v1.SetProtectedRequest
+	73, // 72: api.proto.v1.TwitterAPI.RequestAccess:input_type -> api.proto.v1.RequestAccessRequest
+	75, // 73: api.proto.v1.TwitterAPI.ListAccessRequests:input_type -> api.proto.v1.ListAccessRequestsRequest
+	77, // 74: api.proto.v1.TwitterAPI.ApproveAccessRequest:input_type -> api.proto.v1.ApproveAccessRequestRequest
+	79, // 75: api.proto.v1.TwitterAPI.RevokeAccess:input_type -> api.proto.v1.RevokeAccessRequest
+	82, // 76: api.proto.v1.TwitterAPI.GetProfile:input_type -> api.proto.v1.GetProfileRequest
+	84, // 77: api.proto.v1.TwitterAPI.GetProfileByUsername:input_type -> api.proto.v1.GetProfileByUsernameRequest
+	86, // 78: api.proto.v1.TwitterAPI.UpdateProfile:input_type -> api.proto.v1.UpdateProfileRequest
+	88, // 79: api.proto.v1.TwitterAPI.GetConfigVersion:input_type -> api.proto.v1.GetConfigVersionRequest
+	2,  // 80: api.proto.v1.TwitterAPI.CreateTweet:output_type -> api.proto.v1.CreateTweetResponse
+	4,  // 81: api.proto.v1.TwitterAPI.GetTweetByID:output_type -> api.proto.v1.GetTweetByIDResponse
+	6,  // 82: api.proto.v1.TwitterAPI.BatchGetTweets:output_type -> api.proto.v1.BatchGetTweetsResponse
+	9,  // 83: api.proto.v1.TwitterAPI.GetUserTweets:output_type -> api.proto.v1.GetUserTweetsResponse
+	11, // 84: api.proto.v1.TwitterAPI.UpdateTweet:output_type -> api.proto.v1.UpdateTweetResponse
+	13, // 85: api.proto.v1.TwitterAPI.DeleteTweet:output_type -> api.proto.v1.DeleteTweetResponse
+	15, // 86: api.proto.v1.TwitterAPI.GetSubscribersTweets:output_type -> api.proto.v1.GetSubscribersTweetsResponse
+	25, // 87: api.proto.v1.TwitterAPI.UploadMedia:output_type -> api.proto.v1.UploadMediaResponse
+	22, // 88: api.proto.v1.TwitterAPI.VotePoll:output_type -> api.proto.v1.VotePollResponse
+	29, // 89: api.proto.v1.TwitterAPI.AddBookmark:output_type -> api.proto.v1.AddBookmarkResponse
+	31, // 90: api.proto.v1.TwitterAPI.RemoveBookmark:output_type -> api.proto.v1.RemoveBookmarkResponse
+	33, // 91: api.proto.v1.TwitterAPI.ListBookmarks:output_type -> api.proto.v1.ListBookmarksResponse
+	36, // 92: api.proto.v1.TwitterAPI.CreateBookmarkFolder:output_type -> api.proto.v1.CreateBookmarkFolderResponse
+	38, // 93: api.proto.v1.TwitterAPI.ListBookmarkFolders:output_type -> api.proto.v1.ListBookmarkFoldersResponse
+	40, // 94: api.proto.v1.TwitterAPI.RenameBookmarkFolder:output_type -> api.proto.v1.RenameBookmarkFolderResponse
+	42, // 95: api.proto.v1.TwitterAPI.DeleteBookmarkFolder:output_type -> api.proto.v1.DeleteBookmarkFolderResponse
+	45, // 96: api.proto.v1.TwitterAPI.CreateList:output_type -> api.proto.v1.CreateListResponse
+	47, // 97: api.proto.v1.TwitterAPI.GetList:output_type -> api.proto.v1.GetListResponse
+	49, // 98: api.proto.v1.TwitterAPI.UpdateList:output_type -> api.proto.v1.UpdateListResponse
+	51, // 99: api.proto.v1.TwitterAPI.DeleteList:output_type -> api.proto.v1.DeleteListResponse
+	53, // 100: api.proto.v1.TwitterAPI.GetUserLists:output_type -> api.proto.v1.GetUserListsResponse
+	55, // 101: api.proto.v1.TwitterAPI.AddListMember:output_type -> api.proto.v1.AddListMemberResponse
+	57, // 102: api.proto.v1.TwitterAPI.RemoveListMember:output_type -> api.proto.v1.RemoveListMemberResponse
+	59, // 103: api.proto.v1.TwitterAPI.GetListMembers:output_type -> api.proto.v1.GetListMembersResponse
+	61, // 104: api.proto.v1.TwitterAPI.GetListTimeline:output_type -> api.proto.v1.GetListTimelineResponse
+	63, // 105: api.proto.v1.TwitterAPI.Block:output_type -> api.proto.v1.BlockResponse
+	65, // 106: api.proto.v1.TwitterAPI.Unblock:output_type -> api.proto.v1.UnblockResponse
+	67, // 107: api.proto.v1.TwitterAPI.Mute:output_type -> api.proto.v1.MuteResponse
+	69, // 108: api.proto.v1.TwitterAPI.Unmute:output_type -> api.proto.v1.UnmuteResponse
+	72, // 109: api.proto.v1.TwitterAPI.SetProtected:output_type -> api.proto.v1.SetProtectedResponse
+	74, // 110: api.proto.v1.TwitterAPI.RequestAccess:output_type -> api.proto.v1.RequestAccessResponse
+	76, // 111: api.proto.v1.TwitterAPI.ListAccessRequests:output_type -> api.proto.v1.ListAccessRequestsResponse
+	78, // 112: api.proto.v1.TwitterAPI.ApproveAccessRequest:output_type -> api.proto.v1.ApproveAccessRequestResponse
+	80, // 113: api.proto.v1.TwitterAPI.RevokeAccess:output_type -> api.proto.v1.RevokeAccessResponse
+	83, // 114: api.proto.v1.TwitterAPI.GetProfile:output_type -> api.proto.v1.GetProfileResponse
+	85, // 115: api.proto.v1.TwitterAPI.GetProfileByUsername:output_type -> api.proto.v1.GetProfileByUsernameResponse
+	87, // 116: api.proto.v1.TwitterAPI.UpdateProfile:output_type -> api.proto.v1.UpdateProfileResponse
+	89, // 117: api.proto.v1.TwitterAPI.GetConfigVersion:output_type -> api.proto.v1.GetConfigVersionResponse
+	80, // [80:118] is the sub-list for method output_type
+	42, // [42:80] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_v1_service_proto_init() }
@@ -739,18 +5113,23 @@ func file_api_proto_v1_service_proto_init() {
 	if File_api_proto_v1_service_proto != nil {
 		return
 	}
+	file_api_proto_v1_service_proto_msgTypes[22].OneofWrappers = []any{
+		(*UploadMediaRequest_Info)(nil),
+		(*UploadMediaRequest_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_v1_service_proto_rawDesc), len(file_api_proto_v1_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   13,
+			NumEnums:      1,
+			NumMessages:   89,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_proto_v1_service_proto_goTypes,
 		DependencyIndexes: file_api_proto_v1_service_proto_depIdxs,
+		EnumInfos:         file_api_proto_v1_service_proto_enumTypes,
 		MessageInfos:      file_api_proto_v1_service_proto_msgTypes,
 	}.Build()
 	File_api_proto_v1_service_proto = out.File
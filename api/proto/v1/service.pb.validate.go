@@ -71,6 +71,80 @@ func (m *CreateTweetRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetPoll()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTweetRequestValidationError{
+					field:  "Poll",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTweetRequestValidationError{
+					field:  "Poll",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPoll()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTweetRequestValidationError{
+				field:  "Poll",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetMedia()) > 4 {
+		err := CreateTweetRequestValidationError{
+			field:  "Media",
+			reason: "value must contain no more than 4 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetMedia() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateTweetRequestValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateTweetRequestValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateTweetRequestValidationError{
+					field:  fmt.Sprintf("Media[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CreateTweetRequestMultiError(errors)
 	}
@@ -535,33 +609,32 @@ var _ interface {
 	ErrorName() string
 } = GetTweetByIDResponseValidationError{}
 
-// Validate checks the field values on GetUserTweetsRequest with the rules
+// Validate checks the field values on BatchGetTweetsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetUserTweetsRequest) Validate() error {
+func (m *BatchGetTweetsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserTweetsRequest with the rules
+// ValidateAll checks the field values on BatchGetTweetsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetUserTweetsRequestMultiError, or nil if none found.
-func (m *GetUserTweetsRequest) ValidateAll() error {
+// BatchGetTweetsRequestMultiError, or nil if none found.
+func (m *BatchGetTweetsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserTweetsRequest) validate(all bool) error {
+func (m *BatchGetTweetsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateUuid(m.GetUserId()); err != nil {
-		err = GetUserTweetsRequestValidationError{
-			field:  "UserId",
-			reason: "value must be a valid UUID",
-			cause:  err,
+	if l := len(m.GetIds()); l < 1 || l > 100 {
+		err := BatchGetTweetsRequestValidationError{
+			field:  "Ids",
+			reason: "value must contain between 1 and 100 items, inclusive",
 		}
 		if !all {
 			return err
@@ -569,14 +642,31 @@ func (m *GetUserTweetsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	for idx, item := range m.GetIds() {
+		_, _ = idx, item
+
+		if err := m._validateUuid(item); err != nil {
+			err = BatchGetTweetsRequestValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return GetUserTweetsRequestMultiError(errors)
+		return BatchGetTweetsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *GetUserTweetsRequest) _validateUuid(uuid string) error {
+func (m *BatchGetTweetsRequest) _validateUuid(uuid string) error {
 	if matched := _service_uuidPattern.MatchString(uuid); !matched {
 		return errors.New("invalid uuid format")
 	}
@@ -584,13 +674,13 @@ func (m *GetUserTweetsRequest) _validateUuid(uuid string) error {
 	return nil
 }
 
-// GetUserTweetsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetUserTweetsRequest.ValidateAll() if the designated
+// BatchGetTweetsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetTweetsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetUserTweetsRequestMultiError []error
+type BatchGetTweetsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserTweetsRequestMultiError) Error() string {
+func (m BatchGetTweetsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -599,11 +689,11 @@ func (m GetUserTweetsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserTweetsRequestMultiError) AllErrors() []error { return m }
+func (m BatchGetTweetsRequestMultiError) AllErrors() []error { return m }
 
-// GetUserTweetsRequestValidationError is the validation error returned by
-// GetUserTweetsRequest.Validate if the designated constraints aren't met.
-type GetUserTweetsRequestValidationError struct {
+// BatchGetTweetsRequestValidationError is the validation error returned by
+// BatchGetTweetsRequest.Validate if the designated constraints aren't met.
+type BatchGetTweetsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -611,24 +701,24 @@ type GetUserTweetsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserTweetsRequestValidationError) Field() string { return e.field }
+func (e BatchGetTweetsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserTweetsRequestValidationError) Reason() string { return e.reason }
+func (e BatchGetTweetsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserTweetsRequestValidationError) Cause() error { return e.cause }
+func (e BatchGetTweetsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserTweetsRequestValidationError) Key() bool { return e.key }
+func (e BatchGetTweetsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserTweetsRequestValidationError) ErrorName() string {
-	return "GetUserTweetsRequestValidationError"
+func (e BatchGetTweetsRequestValidationError) ErrorName() string {
+	return "BatchGetTweetsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetUserTweetsRequestValidationError) Error() string {
+func (e BatchGetTweetsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -640,14 +730,14 @@ func (e GetUserTweetsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserTweetsRequest.%s: %s%s",
+		"invalid %sBatchGetTweetsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserTweetsRequestValidationError{}
+var _ error = BatchGetTweetsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -655,47 +745,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserTweetsRequestValidationError{}
+} = BatchGetTweetsRequestValidationError{}
 
-// Validate checks the field values on GetUserTweetsResponse with the rules
+// Validate checks the field values on BatchGetTweetsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetUserTweetsResponse) Validate() error {
+func (m *BatchGetTweetsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserTweetsResponse with the rules
+// ValidateAll checks the field values on BatchGetTweetsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetUserTweetsResponseMultiError, or nil if none found.
-func (m *GetUserTweetsResponse) ValidateAll() error {
+// BatchGetTweetsResponseMultiError, or nil if none found.
+func (m *BatchGetTweetsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserTweetsResponse) validate(all bool) error {
+func (m *BatchGetTweetsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetTweets() {
+	for idx, item := range m.GetResults() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetUserTweetsResponseValidationError{
-						field:  fmt.Sprintf("Tweets[%v]", idx),
+					errors = append(errors, BatchGetTweetsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetUserTweetsResponseValidationError{
-						field:  fmt.Sprintf("Tweets[%v]", idx),
+					errors = append(errors, BatchGetTweetsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -703,8 +793,8 @@ func (m *GetUserTweetsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetUserTweetsResponseValidationError{
-					field:  fmt.Sprintf("Tweets[%v]", idx),
+				return BatchGetTweetsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -714,19 +804,19 @@ func (m *GetUserTweetsResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetUserTweetsResponseMultiError(errors)
+		return BatchGetTweetsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUserTweetsResponseMultiError is an error wrapping multiple validation
-// errors returned by GetUserTweetsResponse.ValidateAll() if the designated
+// BatchGetTweetsResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchGetTweetsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetUserTweetsResponseMultiError []error
+type BatchGetTweetsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserTweetsResponseMultiError) Error() string {
+func (m BatchGetTweetsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -735,11 +825,11 @@ func (m GetUserTweetsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserTweetsResponseMultiError) AllErrors() []error { return m }
+func (m BatchGetTweetsResponseMultiError) AllErrors() []error { return m }
 
-// GetUserTweetsResponseValidationError is the validation error returned by
-// GetUserTweetsResponse.Validate if the designated constraints aren't met.
-type GetUserTweetsResponseValidationError struct {
+// BatchGetTweetsResponseValidationError is the validation error returned by
+// BatchGetTweetsResponse.Validate if the designated constraints aren't met.
+type BatchGetTweetsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -747,24 +837,24 @@ type GetUserTweetsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserTweetsResponseValidationError) Field() string { return e.field }
+func (e BatchGetTweetsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserTweetsResponseValidationError) Reason() string { return e.reason }
+func (e BatchGetTweetsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserTweetsResponseValidationError) Cause() error { return e.cause }
+func (e BatchGetTweetsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserTweetsResponseValidationError) Key() bool { return e.key }
+func (e BatchGetTweetsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserTweetsResponseValidationError) ErrorName() string {
-	return "GetUserTweetsResponseValidationError"
+func (e BatchGetTweetsResponseValidationError) ErrorName() string {
+	return "BatchGetTweetsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetUserTweetsResponseValidationError) Error() string {
+func (e BatchGetTweetsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -776,14 +866,14 @@ func (e GetUserTweetsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserTweetsResponse.%s: %s%s",
+		"invalid %sBatchGetTweetsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserTweetsResponseValidationError{}
+var _ error = BatchGetTweetsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -791,75 +881,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserTweetsResponseValidationError{}
+} = BatchGetTweetsResponseValidationError{}
 
-// Validate checks the field values on UpdateTweetRequest with the rules
+// Validate checks the field values on BatchGetTweetsResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateTweetRequest) Validate() error {
+func (m *BatchGetTweetsResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateTweetRequest with the rules
+// ValidateAll checks the field values on BatchGetTweetsResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateTweetRequestMultiError, or nil if none found.
-func (m *UpdateTweetRequest) ValidateAll() error {
+// BatchGetTweetsResultMultiError, or nil if none found.
+func (m *BatchGetTweetsResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateTweetRequest) validate(all bool) error {
+func (m *BatchGetTweetsResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateUuid(m.GetId()); err != nil {
-		err = UpdateTweetRequestValidationError{
-			field:  "Id",
-			reason: "value must be a valid UUID",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Id
 
-	if l := utf8.RuneCountInString(m.GetText()); l < 1 || l > 250 {
-		err := UpdateTweetRequestValidationError{
-			field:  "Text",
-			reason: "value length must be between 1 and 250 runes, inclusive",
+	if all {
+		switch v := interface{}(m.GetTweet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BatchGetTweetsResultValidationError{
+					field:  "Tweet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BatchGetTweetsResultValidationError{
+					field:  "Tweet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetTweet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchGetTweetsResultValidationError{
+				field:  "Tweet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
-	}
-
-	if len(errors) > 0 {
-		return UpdateTweetRequestMultiError(errors)
 	}
 
-	return nil
-}
+	// no validation rules for NotFound
 
-func (m *UpdateTweetRequest) _validateUuid(uuid string) error {
-	if matched := _service_uuidPattern.MatchString(uuid); !matched {
-		return errors.New("invalid uuid format")
+	if len(errors) > 0 {
+		return BatchGetTweetsResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateTweetRequestMultiError is an error wrapping multiple validation errors
-// returned by UpdateTweetRequest.ValidateAll() if the designated constraints
-// aren't met.
-type UpdateTweetRequestMultiError []error
+// BatchGetTweetsResultMultiError is an error wrapping multiple validation
+// errors returned by BatchGetTweetsResult.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetTweetsResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateTweetRequestMultiError) Error() string {
+func (m BatchGetTweetsResultMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -868,11 +960,11 @@ func (m UpdateTweetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateTweetRequestMultiError) AllErrors() []error { return m }
+func (m BatchGetTweetsResultMultiError) AllErrors() []error { return m }
 
-// UpdateTweetRequestValidationError is the validation error returned by
-// UpdateTweetRequest.Validate if the designated constraints aren't met.
-type UpdateTweetRequestValidationError struct {
+// BatchGetTweetsResultValidationError is the validation error returned by
+// BatchGetTweetsResult.Validate if the designated constraints aren't met.
+type BatchGetTweetsResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -880,24 +972,24 @@ type UpdateTweetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateTweetRequestValidationError) Field() string { return e.field }
+func (e BatchGetTweetsResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateTweetRequestValidationError) Reason() string { return e.reason }
+func (e BatchGetTweetsResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateTweetRequestValidationError) Cause() error { return e.cause }
+func (e BatchGetTweetsResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateTweetRequestValidationError) Key() bool { return e.key }
+func (e BatchGetTweetsResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateTweetRequestValidationError) ErrorName() string {
-	return "UpdateTweetRequestValidationError"
+func (e BatchGetTweetsResultValidationError) ErrorName() string {
+	return "BatchGetTweetsResultValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateTweetRequestValidationError) Error() string {
+func (e BatchGetTweetsResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -909,14 +1001,14 @@ func (e UpdateTweetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateTweetRequest.%s: %s%s",
+		"invalid %sBatchGetTweetsResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateTweetRequestValidationError{}
+var _ error = BatchGetTweetsResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -924,73 +1016,9527 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateTweetRequestValidationError{}
+} = BatchGetTweetsResultValidationError{}
 
-// Validate checks the field values on UpdateTweetResponse with the rules
+// Validate checks the field values on GetUserTweetsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateTweetResponse) Validate() error {
+func (m *GetUserTweetsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateTweetResponse with the rules
+// ValidateAll checks the field values on GetUserTweetsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateTweetResponseMultiError, or nil if none found.
-func (m *UpdateTweetResponse) ValidateAll() error {
+// GetUserTweetsRequestMultiError, or nil if none found.
+func (m *GetUserTweetsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateTweetResponse) validate(all bool) error {
+func (m *GetUserTweetsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTweet()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateTweetResponseValidationError{
-					field:  "Tweet",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateTweetResponseValidationError{
-					field:  "Tweet",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = GetUserTweetsRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
 		}
-	} else if v, ok := interface{}(m.GetTweet()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateTweetResponseValidationError{
-				field:  "Tweet",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	if len(errors) > 0 {
-		return UpdateTweetResponseMultiError(errors)
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := GetUserTweetsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return GetUserTweetsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetUserTweetsRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetUserTweetsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUserTweetsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserTweetsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserTweetsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserTweetsRequestMultiError) AllErrors() []error { return m }
+
+// GetUserTweetsRequestValidationError is the validation error returned by
+// GetUserTweetsRequest.Validate if the designated constraints aren't met.
+type GetUserTweetsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserTweetsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserTweetsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserTweetsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserTweetsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserTweetsRequestValidationError) ErrorName() string {
+	return "GetUserTweetsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserTweetsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserTweetsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserTweetsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserTweetsRequestValidationError{}
+
+// Validate checks the field values on GetUserTweetsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserTweetsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserTweetsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserTweetsResponseMultiError, or nil if none found.
+func (m *GetUserTweetsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserTweetsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTweets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUserTweetsResponseValidationError{
+						field:  fmt.Sprintf("Tweets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUserTweetsResponseValidationError{
+						field:  fmt.Sprintf("Tweets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUserTweetsResponseValidationError{
+					field:  fmt.Sprintf("Tweets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return GetUserTweetsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserTweetsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetUserTweetsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserTweetsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserTweetsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserTweetsResponseMultiError) AllErrors() []error { return m }
+
+// GetUserTweetsResponseValidationError is the validation error returned by
+// GetUserTweetsResponse.Validate if the designated constraints aren't met.
+type GetUserTweetsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserTweetsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserTweetsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserTweetsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserTweetsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserTweetsResponseValidationError) ErrorName() string {
+	return "GetUserTweetsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserTweetsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserTweetsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserTweetsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserTweetsResponseValidationError{}
+
+// Validate checks the field values on UpdateTweetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTweetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTweetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTweetRequestMultiError, or nil if none found.
+func (m *UpdateTweetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTweetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = UpdateTweetRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetText()); l < 1 || l > 250 {
+		err := UpdateTweetRequestValidationError{
+			field:  "Text",
+			reason: "value length must be between 1 and 250 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetExpectedVersion() < 0 {
+		err := UpdateTweetRequestValidationError{
+			field:  "ExpectedVersion",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateTweetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateTweetRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateTweetRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateTweetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateTweetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTweetRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTweetRequestMultiError) AllErrors() []error { return m }
+
+// UpdateTweetRequestValidationError is the validation error returned by
+// UpdateTweetRequest.Validate if the designated constraints aren't met.
+type UpdateTweetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTweetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTweetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTweetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTweetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTweetRequestValidationError) ErrorName() string {
+	return "UpdateTweetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTweetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTweetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTweetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTweetRequestValidationError{}
+
+// Validate checks the field values on UpdateTweetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTweetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTweetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTweetResponseMultiError, or nil if none found.
+func (m *UpdateTweetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTweetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTweet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTweetResponseValidationError{
+					field:  "Tweet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTweetResponseValidationError{
+					field:  "Tweet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTweet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTweetResponseValidationError{
+				field:  "Tweet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateTweetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTweetResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateTweetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateTweetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTweetResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTweetResponseMultiError) AllErrors() []error { return m }
+
+// UpdateTweetResponseValidationError is the validation error returned by
+// UpdateTweetResponse.Validate if the designated constraints aren't met.
+type UpdateTweetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTweetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTweetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTweetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTweetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTweetResponseValidationError) ErrorName() string {
+	return "UpdateTweetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTweetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTweetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTweetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTweetResponseValidationError{}
+
+// Validate checks the field values on DeleteTweetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTweetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTweetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTweetRequestMultiError, or nil if none found.
+func (m *DeleteTweetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTweetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = DeleteTweetRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteTweetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteTweetRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteTweetRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteTweetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteTweetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTweetRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTweetRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTweetRequestValidationError is the validation error returned by
+// DeleteTweetRequest.Validate if the designated constraints aren't met.
+type DeleteTweetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTweetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTweetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTweetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTweetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTweetRequestValidationError) ErrorName() string {
+	return "DeleteTweetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTweetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTweetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTweetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTweetRequestValidationError{}
+
+// Validate checks the field values on DeleteTweetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTweetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTweetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTweetResponseMultiError, or nil if none found.
+func (m *DeleteTweetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTweetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteTweetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTweetResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteTweetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTweetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTweetResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTweetResponseMultiError) AllErrors() []error { return m }
+
+// DeleteTweetResponseValidationError is the validation error returned by
+// DeleteTweetResponse.Validate if the designated constraints aren't met.
+type DeleteTweetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTweetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTweetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTweetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTweetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTweetResponseValidationError) ErrorName() string {
+	return "DeleteTweetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTweetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTweetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTweetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTweetResponseValidationError{}
+
+// Validate checks the field values on GetSubscribersTweetsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSubscribersTweetsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSubscribersTweetsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSubscribersTweetsRequestMultiError, or nil if none found.
+func (m *GetSubscribersTweetsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSubscribersTweetsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := GetSubscribersTweetsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return GetSubscribersTweetsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSubscribersTweetsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetSubscribersTweetsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetSubscribersTweetsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSubscribersTweetsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSubscribersTweetsRequestMultiError) AllErrors() []error { return m }
+
+// GetSubscribersTweetsRequestValidationError is the validation error returned
+// by GetSubscribersTweetsRequest.Validate if the designated constraints
+// aren't met.
+type GetSubscribersTweetsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSubscribersTweetsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSubscribersTweetsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSubscribersTweetsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSubscribersTweetsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSubscribersTweetsRequestValidationError) ErrorName() string {
+	return "GetSubscribersTweetsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSubscribersTweetsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSubscribersTweetsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSubscribersTweetsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSubscribersTweetsRequestValidationError{}
+
+// Validate checks the field values on GetSubscribersTweetsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSubscribersTweetsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSubscribersTweetsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSubscribersTweetsResponseMultiError, or nil if none found.
+func (m *GetSubscribersTweetsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSubscribersTweetsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTweets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSubscribersTweetsResponseValidationError{
+						field:  fmt.Sprintf("Tweets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSubscribersTweetsResponseValidationError{
+						field:  fmt.Sprintf("Tweets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSubscribersTweetsResponseValidationError{
+					field:  fmt.Sprintf("Tweets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return GetSubscribersTweetsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSubscribersTweetsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetSubscribersTweetsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetSubscribersTweetsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSubscribersTweetsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSubscribersTweetsResponseMultiError) AllErrors() []error { return m }
+
+// GetSubscribersTweetsResponseValidationError is the validation error returned
+// by GetSubscribersTweetsResponse.Validate if the designated constraints
+// aren't met.
+type GetSubscribersTweetsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSubscribersTweetsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSubscribersTweetsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSubscribersTweetsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSubscribersTweetsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSubscribersTweetsResponseValidationError) ErrorName() string {
+	return "GetSubscribersTweetsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSubscribersTweetsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSubscribersTweetsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSubscribersTweetsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSubscribersTweetsResponseValidationError{}
+
+// Validate checks the field values on Tweet with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Tweet) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tweet with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TweetMultiError, or nil if none found.
+func (m *Tweet) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tweet) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = TweetValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetText()); l < 1 || l > 250 {
+		err := TweetValidationError{
+			field:  "Text",
+			reason: "value length must be between 1 and 250 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TweetValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TweetValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TweetValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TweetValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TweetValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TweetValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = TweetValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPoll()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TweetValidationError{
+					field:  "Poll",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TweetValidationError{
+					field:  "Poll",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPoll()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TweetValidationError{
+				field:  "Poll",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetMedia() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TweetValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TweetValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TweetValidationError{
+					field:  fmt.Sprintf("Media[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Bookmarked
+
+	if all {
+		switch v := interface{}(m.GetAuthor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TweetValidationError{
+					field:  "Author",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TweetValidationError{
+					field:  "Author",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuthor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TweetValidationError{
+				field:  "Author",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return TweetMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *Tweet) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// TweetMultiError is an error wrapping multiple validation errors returned by
+// Tweet.ValidateAll() if the designated constraints aren't met.
+type TweetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TweetMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TweetMultiError) AllErrors() []error { return m }
+
+// TweetValidationError is the validation error returned by Tweet.Validate if
+// the designated constraints aren't met.
+type TweetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TweetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TweetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TweetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TweetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TweetValidationError) ErrorName() string { return "TweetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TweetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTweet.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TweetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TweetValidationError{}
+
+// Validate checks the field values on Author with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Author) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Author with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AuthorMultiError, or nil if none found.
+func (m *Author) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Author) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Username
+
+	// no validation rules for DisplayName
+
+	// no validation rules for AvatarUrl
+
+	if len(errors) > 0 {
+		return AuthorMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorMultiError is an error wrapping multiple validation errors returned by
+// Author.ValidateAll() if the designated constraints aren't met.
+type AuthorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorMultiError) AllErrors() []error { return m }
+
+// AuthorValidationError is the validation error returned by Author.Validate if
+// the designated constraints aren't met.
+type AuthorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorValidationError) ErrorName() string { return "AuthorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthor.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorValidationError{}
+
+// Validate checks the field values on CreatePoll with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CreatePoll) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePoll with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CreatePollMultiError, or
+// nil if none found.
+func (m *CreatePoll) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePoll) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetOptions()); l < 2 || l > 4 {
+		err := CreatePollValidationError{
+			field:  "Options",
+			reason: "value must contain between 2 and 4 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetOptions() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 25 {
+			err := CreatePollValidationError{
+				field:  fmt.Sprintf("Options[%v]", idx),
+				reason: "value length must be between 1 and 25 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetClosesAt() == nil {
+		err := CreatePollValidationError{
+			field:  "ClosesAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if t := m.GetClosesAt(); t != nil {
+		ts, err := t.AsTime(), t.CheckValid()
+		if err != nil {
+			err = CreatePollValidationError{
+				field:  "ClosesAt",
+				reason: "value is not a valid timestamp",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			now := time.Now()
+			within := time.Duration(604800*time.Second + 0*time.Nanosecond)
+
+			if ts.Sub(now) <= 0 || ts.Sub(now.Add(within)) > 0 {
+				err := CreatePollValidationError{
+					field:  "ClosesAt",
+					reason: "value must be greater than now within 168h0m0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreatePollMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePollMultiError is an error wrapping multiple validation errors
+// returned by CreatePoll.ValidateAll() if the designated constraints aren't met.
+type CreatePollMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePollMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePollMultiError) AllErrors() []error { return m }
+
+// CreatePollValidationError is the validation error returned by
+// CreatePoll.Validate if the designated constraints aren't met.
+type CreatePollValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePollValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePollValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePollValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePollValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePollValidationError) ErrorName() string { return "CreatePollValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreatePollValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePoll.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePollValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePollValidationError{}
+
+// Validate checks the field values on Poll with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Poll) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Poll with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in PollMultiError, or nil if none found.
+func (m *Poll) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Poll) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOptions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PollValidationError{
+						field:  fmt.Sprintf("Options[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PollValidationError{
+						field:  fmt.Sprintf("Options[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PollValidationError{
+					field:  fmt.Sprintf("Options[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetClosesAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PollValidationError{
+					field:  "ClosesAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PollValidationError{
+					field:  "ClosesAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClosesAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PollValidationError{
+				field:  "ClosesAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Closed
+
+	// no validation rules for TotalVotes
+
+	if len(errors) > 0 {
+		return PollMultiError(errors)
+	}
+
+	return nil
+}
+
+// PollMultiError is an error wrapping multiple validation errors returned by
+// Poll.ValidateAll() if the designated constraints aren't met.
+type PollMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PollMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PollMultiError) AllErrors() []error { return m }
+
+// PollValidationError is the validation error returned by Poll.Validate if the
+// designated constraints aren't met.
+type PollValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PollValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PollValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PollValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PollValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PollValidationError) ErrorName() string { return "PollValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PollValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPoll.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PollValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PollValidationError{}
+
+// Validate checks the field values on PollOption with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PollOption) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PollOption with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PollOptionMultiError, or
+// nil if none found.
+func (m *PollOption) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PollOption) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Position
+
+	// no validation rules for Text
+
+	// no validation rules for Votes
+
+	if len(errors) > 0 {
+		return PollOptionMultiError(errors)
+	}
+
+	return nil
+}
+
+// PollOptionMultiError is an error wrapping multiple validation errors
+// returned by PollOption.ValidateAll() if the designated constraints aren't met.
+type PollOptionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PollOptionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PollOptionMultiError) AllErrors() []error { return m }
+
+// PollOptionValidationError is the validation error returned by
+// PollOption.Validate if the designated constraints aren't met.
+type PollOptionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PollOptionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PollOptionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PollOptionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PollOptionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PollOptionValidationError) ErrorName() string { return "PollOptionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PollOptionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPollOption.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PollOptionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PollOptionValidationError{}
+
+// Validate checks the field values on VotePollRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *VotePollRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VotePollRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VotePollRequestMultiError, or nil if none found.
+func (m *VotePollRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VotePollRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetTweetId()); err != nil {
+		err = VotePollRequestValidationError{
+			field:  "TweetId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPosition(); val < 0 || val >= 4 {
+		err := VotePollRequestValidationError{
+			field:  "Position",
+			reason: "value must be inside range [0, 4)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return VotePollRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *VotePollRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// VotePollRequestMultiError is an error wrapping multiple validation errors
+// returned by VotePollRequest.ValidateAll() if the designated constraints
+// aren't met.
+type VotePollRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VotePollRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VotePollRequestMultiError) AllErrors() []error { return m }
+
+// VotePollRequestValidationError is the validation error returned by
+// VotePollRequest.Validate if the designated constraints aren't met.
+type VotePollRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VotePollRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VotePollRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VotePollRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VotePollRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VotePollRequestValidationError) ErrorName() string { return "VotePollRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VotePollRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVotePollRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VotePollRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VotePollRequestValidationError{}
+
+// Validate checks the field values on VotePollResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *VotePollResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VotePollResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VotePollResponseMultiError, or nil if none found.
+func (m *VotePollResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VotePollResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPoll()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VotePollResponseValidationError{
+					field:  "Poll",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VotePollResponseValidationError{
+					field:  "Poll",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPoll()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VotePollResponseValidationError{
+				field:  "Poll",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return VotePollResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VotePollResponseMultiError is an error wrapping multiple validation errors
+// returned by VotePollResponse.ValidateAll() if the designated constraints
+// aren't met.
+type VotePollResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VotePollResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VotePollResponseMultiError) AllErrors() []error { return m }
+
+// VotePollResponseValidationError is the validation error returned by
+// VotePollResponse.Validate if the designated constraints aren't met.
+type VotePollResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VotePollResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VotePollResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VotePollResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VotePollResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VotePollResponseValidationError) ErrorName() string { return "VotePollResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VotePollResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVotePollResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VotePollResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VotePollResponseValidationError{}
+
+// Validate checks the field values on UploadMediaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadMediaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadMediaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadMediaRequestMultiError, or nil if none found.
+func (m *UploadMediaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadMediaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Payload.(type) {
+	case *UploadMediaRequest_Info:
+		if v == nil {
+			err := UploadMediaRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetInfo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadMediaRequestValidationError{
+						field:  "Info",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadMediaRequestValidationError{
+						field:  "Info",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadMediaRequestValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UploadMediaRequest_Chunk:
+		if v == nil {
+			err := UploadMediaRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Chunk
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return UploadMediaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadMediaRequestMultiError is an error wrapping multiple validation errors
+// returned by UploadMediaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UploadMediaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadMediaRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadMediaRequestMultiError) AllErrors() []error { return m }
+
+// UploadMediaRequestValidationError is the validation error returned by
+// UploadMediaRequest.Validate if the designated constraints aren't met.
+type UploadMediaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadMediaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadMediaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadMediaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadMediaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadMediaRequestValidationError) ErrorName() string {
+	return "UploadMediaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadMediaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadMediaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadMediaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadMediaRequestValidationError{}
+
+// Validate checks the field values on MediaInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MediaInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MediaInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MediaInfoMultiError, or nil
+// if none found.
+func (m *MediaInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MediaInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _MediaInfo_MimeType_InLookup[m.GetMimeType()]; !ok {
+		err := MediaInfoValidationError{
+			field:  "MimeType",
+			reason: "value must be in list [image/jpeg image/png]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MediaInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// MediaInfoMultiError is an error wrapping multiple validation errors returned
+// by MediaInfo.ValidateAll() if the designated constraints aren't met.
+type MediaInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MediaInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MediaInfoMultiError) AllErrors() []error { return m }
+
+// MediaInfoValidationError is the validation error returned by
+// MediaInfo.Validate if the designated constraints aren't met.
+type MediaInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MediaInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MediaInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MediaInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MediaInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MediaInfoValidationError) ErrorName() string { return "MediaInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MediaInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMediaInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MediaInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MediaInfoValidationError{}
+
+var _MediaInfo_MimeType_InLookup = map[string]struct{}{
+	"image/jpeg": {},
+	"image/png":  {},
+}
+
+// Validate checks the field values on UploadMediaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadMediaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadMediaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadMediaResponseMultiError, or nil if none found.
+func (m *UploadMediaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadMediaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMedia()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadMediaResponseValidationError{
+					field:  "Media",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadMediaResponseValidationError{
+					field:  "Media",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMedia()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadMediaResponseValidationError{
+				field:  "Media",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UploadMediaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadMediaResponseMultiError is an error wrapping multiple validation
+// errors returned by UploadMediaResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UploadMediaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadMediaResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadMediaResponseMultiError) AllErrors() []error { return m }
+
+// UploadMediaResponseValidationError is the validation error returned by
+// UploadMediaResponse.Validate if the designated constraints aren't met.
+type UploadMediaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadMediaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadMediaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadMediaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadMediaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadMediaResponseValidationError) ErrorName() string {
+	return "UploadMediaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadMediaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadMediaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadMediaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadMediaResponseValidationError{}
+
+// Validate checks the field values on MediaAttachment with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MediaAttachment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MediaAttachment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MediaAttachmentMultiError, or nil if none found.
+func (m *MediaAttachment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MediaAttachment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetMediaId()); err != nil {
+		err = MediaAttachmentValidationError{
+			field:  "MediaId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAltText()) > 1000 {
+		err := MediaAttachmentValidationError{
+			field:  "AltText",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MediaAttachmentMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *MediaAttachment) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// MediaAttachmentMultiError is an error wrapping multiple validation errors
+// returned by MediaAttachment.ValidateAll() if the designated constraints
+// aren't met.
+type MediaAttachmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MediaAttachmentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MediaAttachmentMultiError) AllErrors() []error { return m }
+
+// MediaAttachmentValidationError is the validation error returned by
+// MediaAttachment.Validate if the designated constraints aren't met.
+type MediaAttachmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MediaAttachmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MediaAttachmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MediaAttachmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MediaAttachmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MediaAttachmentValidationError) ErrorName() string { return "MediaAttachmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MediaAttachmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMediaAttachment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MediaAttachmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MediaAttachmentValidationError{}
+
+// Validate checks the field values on Media with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Media) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Media with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MediaMultiError, or nil if none found.
+func (m *Media) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Media) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for MimeType
+
+	// no validation rules for Size
+
+	// no validation rules for Width
+
+	// no validation rules for Height
+
+	// no validation rules for Url
+
+	// no validation rules for ThumbnailUrl
+
+	// no validation rules for AltText
+
+	if len(errors) > 0 {
+		return MediaMultiError(errors)
+	}
+
+	return nil
+}
+
+// MediaMultiError is an error wrapping multiple validation errors returned by
+// Media.ValidateAll() if the designated constraints aren't met.
+type MediaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MediaMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MediaMultiError) AllErrors() []error { return m }
+
+// MediaValidationError is the validation error returned by Media.Validate if
+// the designated constraints aren't met.
+type MediaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MediaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MediaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MediaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MediaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MediaValidationError) ErrorName() string { return "MediaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MediaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMedia.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MediaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MediaValidationError{}
+
+// Validate checks the field values on AddBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddBookmarkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddBookmarkRequestMultiError, or nil if none found.
+func (m *AddBookmarkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddBookmarkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetTweetId()); err != nil {
+		err = AddBookmarkRequestValidationError{
+			field:  "TweetId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFolderId() != "" {
+
+		if err := m._validateUuid(m.GetFolderId()); err != nil {
+			err = AddBookmarkRequestValidationError{
+				field:  "FolderId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AddBookmarkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AddBookmarkRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// AddBookmarkRequestMultiError is an error wrapping multiple validation errors
+// returned by AddBookmarkRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddBookmarkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddBookmarkRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddBookmarkRequestMultiError) AllErrors() []error { return m }
+
+// AddBookmarkRequestValidationError is the validation error returned by
+// AddBookmarkRequest.Validate if the designated constraints aren't met.
+type AddBookmarkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddBookmarkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddBookmarkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddBookmarkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddBookmarkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddBookmarkRequestValidationError) ErrorName() string {
+	return "AddBookmarkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddBookmarkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddBookmarkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddBookmarkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddBookmarkRequestValidationError{}
+
+// Validate checks the field values on AddBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddBookmarkResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddBookmarkResponseMultiError, or nil if none found.
+func (m *AddBookmarkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddBookmarkResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AddBookmarkResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by AddBookmarkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddBookmarkResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddBookmarkResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddBookmarkResponseMultiError) AllErrors() []error { return m }
+
+// AddBookmarkResponseValidationError is the validation error returned by
+// AddBookmarkResponse.Validate if the designated constraints aren't met.
+type AddBookmarkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddBookmarkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddBookmarkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddBookmarkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddBookmarkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddBookmarkResponseValidationError) ErrorName() string {
+	return "AddBookmarkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddBookmarkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddBookmarkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddBookmarkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddBookmarkResponseValidationError{}
+
+// Validate checks the field values on RemoveBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveBookmarkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveBookmarkRequestMultiError, or nil if none found.
+func (m *RemoveBookmarkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveBookmarkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetTweetId()); err != nil {
+		err = RemoveBookmarkRequestValidationError{
+			field:  "TweetId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveBookmarkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RemoveBookmarkRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RemoveBookmarkRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveBookmarkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveBookmarkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveBookmarkRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveBookmarkRequestMultiError) AllErrors() []error { return m }
+
+// RemoveBookmarkRequestValidationError is the validation error returned by
+// RemoveBookmarkRequest.Validate if the designated constraints aren't met.
+type RemoveBookmarkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveBookmarkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveBookmarkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveBookmarkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveBookmarkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveBookmarkRequestValidationError) ErrorName() string {
+	return "RemoveBookmarkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveBookmarkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveBookmarkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveBookmarkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveBookmarkRequestValidationError{}
+
+// Validate checks the field values on RemoveBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveBookmarkResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveBookmarkResponseMultiError, or nil if none found.
+func (m *RemoveBookmarkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveBookmarkResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveBookmarkResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by RemoveBookmarkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveBookmarkResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveBookmarkResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveBookmarkResponseMultiError) AllErrors() []error { return m }
+
+// RemoveBookmarkResponseValidationError is the validation error returned by
+// RemoveBookmarkResponse.Validate if the designated constraints aren't met.
+type RemoveBookmarkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveBookmarkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveBookmarkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveBookmarkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveBookmarkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveBookmarkResponseValidationError) ErrorName() string {
+	return "RemoveBookmarkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveBookmarkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveBookmarkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveBookmarkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveBookmarkResponseValidationError{}
+
+// Validate checks the field values on ListBookmarksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBookmarksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBookmarksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBookmarksRequestMultiError, or nil if none found.
+func (m *ListBookmarksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBookmarksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetFolderId() != "" {
+
+		if err := m._validateUuid(m.GetFolderId()); err != nil {
+			err = ListBookmarksRequestValidationError{
+				field:  "FolderId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListBookmarksRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListBookmarksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListBookmarksRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListBookmarksRequestMultiError is an error wrapping multiple validation
+// errors returned by ListBookmarksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListBookmarksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBookmarksRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBookmarksRequestMultiError) AllErrors() []error { return m }
+
+// ListBookmarksRequestValidationError is the validation error returned by
+// ListBookmarksRequest.Validate if the designated constraints aren't met.
+type ListBookmarksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBookmarksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBookmarksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBookmarksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBookmarksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBookmarksRequestValidationError) ErrorName() string {
+	return "ListBookmarksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBookmarksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBookmarksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBookmarksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBookmarksRequestValidationError{}
+
+// Validate checks the field values on ListBookmarksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBookmarksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBookmarksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBookmarksResponseMultiError, or nil if none found.
+func (m *ListBookmarksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBookmarksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTweets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBookmarksResponseValidationError{
+						field:  fmt.Sprintf("Tweets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBookmarksResponseValidationError{
+						field:  fmt.Sprintf("Tweets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBookmarksResponseValidationError{
+					field:  fmt.Sprintf("Tweets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListBookmarksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBookmarksResponseMultiError is an error wrapping multiple validation
+// errors returned by ListBookmarksResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListBookmarksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBookmarksResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBookmarksResponseMultiError) AllErrors() []error { return m }
+
+// ListBookmarksResponseValidationError is the validation error returned by
+// ListBookmarksResponse.Validate if the designated constraints aren't met.
+type ListBookmarksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBookmarksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBookmarksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBookmarksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBookmarksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBookmarksResponseValidationError) ErrorName() string {
+	return "ListBookmarksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBookmarksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBookmarksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBookmarksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBookmarksResponseValidationError{}
+
+// Validate checks the field values on BookmarkFolder with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BookmarkFolder) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookmarkFolder with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BookmarkFolderMultiError,
+// or nil if none found.
+func (m *BookmarkFolder) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookmarkFolder) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookmarkFolderValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookmarkFolderValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookmarkFolderValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BookmarkFolderMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookmarkFolderMultiError is an error wrapping multiple validation errors
+// returned by BookmarkFolder.ValidateAll() if the designated constraints
+// aren't met.
+type BookmarkFolderMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookmarkFolderMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookmarkFolderMultiError) AllErrors() []error { return m }
+
+// BookmarkFolderValidationError is the validation error returned by
+// BookmarkFolder.Validate if the designated constraints aren't met.
+type BookmarkFolderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookmarkFolderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookmarkFolderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookmarkFolderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookmarkFolderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookmarkFolderValidationError) ErrorName() string { return "BookmarkFolderValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BookmarkFolderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookmarkFolder.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookmarkFolderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookmarkFolderValidationError{}
+
+// Validate checks the field values on CreateBookmarkFolderRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateBookmarkFolderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBookmarkFolderRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBookmarkFolderRequestMultiError, or nil if none found.
+func (m *CreateBookmarkFolderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBookmarkFolderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 50 {
+		err := CreateBookmarkFolderRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateBookmarkFolderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBookmarkFolderRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateBookmarkFolderRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateBookmarkFolderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBookmarkFolderRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBookmarkFolderRequestMultiError) AllErrors() []error { return m }
+
+// CreateBookmarkFolderRequestValidationError is the validation error returned
+// by CreateBookmarkFolderRequest.Validate if the designated constraints
+// aren't met.
+type CreateBookmarkFolderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBookmarkFolderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBookmarkFolderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBookmarkFolderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBookmarkFolderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBookmarkFolderRequestValidationError) ErrorName() string {
+	return "CreateBookmarkFolderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBookmarkFolderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBookmarkFolderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBookmarkFolderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBookmarkFolderRequestValidationError{}
+
+// Validate checks the field values on CreateBookmarkFolderResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateBookmarkFolderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBookmarkFolderResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBookmarkFolderResponseMultiError, or nil if none found.
+func (m *CreateBookmarkFolderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBookmarkFolderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFolder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBookmarkFolderResponseValidationError{
+					field:  "Folder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateBookmarkFolderResponseValidationError{
+					field:  "Folder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFolder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBookmarkFolderResponseValidationError{
+				field:  "Folder",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateBookmarkFolderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBookmarkFolderResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateBookmarkFolderResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateBookmarkFolderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBookmarkFolderResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBookmarkFolderResponseMultiError) AllErrors() []error { return m }
+
+// CreateBookmarkFolderResponseValidationError is the validation error returned
+// by CreateBookmarkFolderResponse.Validate if the designated constraints
+// aren't met.
+type CreateBookmarkFolderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBookmarkFolderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBookmarkFolderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBookmarkFolderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBookmarkFolderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBookmarkFolderResponseValidationError) ErrorName() string {
+	return "CreateBookmarkFolderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBookmarkFolderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBookmarkFolderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBookmarkFolderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBookmarkFolderResponseValidationError{}
+
+// Validate checks the field values on ListBookmarkFoldersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBookmarkFoldersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBookmarkFoldersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBookmarkFoldersRequestMultiError, or nil if none found.
+func (m *ListBookmarkFoldersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBookmarkFoldersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListBookmarkFoldersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBookmarkFoldersRequestMultiError is an error wrapping multiple
+// validation errors returned by ListBookmarkFoldersRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListBookmarkFoldersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBookmarkFoldersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBookmarkFoldersRequestMultiError) AllErrors() []error { return m }
+
+// ListBookmarkFoldersRequestValidationError is the validation error returned
+// by ListBookmarkFoldersRequest.Validate if the designated constraints aren't met.
+type ListBookmarkFoldersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBookmarkFoldersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBookmarkFoldersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBookmarkFoldersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBookmarkFoldersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBookmarkFoldersRequestValidationError) ErrorName() string {
+	return "ListBookmarkFoldersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBookmarkFoldersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBookmarkFoldersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBookmarkFoldersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBookmarkFoldersRequestValidationError{}
+
+// Validate checks the field values on ListBookmarkFoldersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBookmarkFoldersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBookmarkFoldersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBookmarkFoldersResponseMultiError, or nil if none found.
+func (m *ListBookmarkFoldersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBookmarkFoldersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFolders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBookmarkFoldersResponseValidationError{
+						field:  fmt.Sprintf("Folders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBookmarkFoldersResponseValidationError{
+						field:  fmt.Sprintf("Folders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBookmarkFoldersResponseValidationError{
+					field:  fmt.Sprintf("Folders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListBookmarkFoldersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBookmarkFoldersResponseMultiError is an error wrapping multiple
+// validation errors returned by ListBookmarkFoldersResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListBookmarkFoldersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBookmarkFoldersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBookmarkFoldersResponseMultiError) AllErrors() []error { return m }
+
+// ListBookmarkFoldersResponseValidationError is the validation error returned
+// by ListBookmarkFoldersResponse.Validate if the designated constraints
+// aren't met.
+type ListBookmarkFoldersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBookmarkFoldersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBookmarkFoldersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBookmarkFoldersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBookmarkFoldersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBookmarkFoldersResponseValidationError) ErrorName() string {
+	return "ListBookmarkFoldersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBookmarkFoldersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBookmarkFoldersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBookmarkFoldersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBookmarkFoldersResponseValidationError{}
+
+// Validate checks the field values on RenameBookmarkFolderRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenameBookmarkFolderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenameBookmarkFolderRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenameBookmarkFolderRequestMultiError, or nil if none found.
+func (m *RenameBookmarkFolderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenameBookmarkFolderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = RenameBookmarkFolderRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 50 {
+		err := RenameBookmarkFolderRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RenameBookmarkFolderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RenameBookmarkFolderRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RenameBookmarkFolderRequestMultiError is an error wrapping multiple
+// validation errors returned by RenameBookmarkFolderRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RenameBookmarkFolderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenameBookmarkFolderRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenameBookmarkFolderRequestMultiError) AllErrors() []error { return m }
+
+// RenameBookmarkFolderRequestValidationError is the validation error returned
+// by RenameBookmarkFolderRequest.Validate if the designated constraints
+// aren't met.
+type RenameBookmarkFolderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenameBookmarkFolderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenameBookmarkFolderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenameBookmarkFolderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenameBookmarkFolderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenameBookmarkFolderRequestValidationError) ErrorName() string {
+	return "RenameBookmarkFolderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenameBookmarkFolderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenameBookmarkFolderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenameBookmarkFolderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenameBookmarkFolderRequestValidationError{}
+
+// Validate checks the field values on RenameBookmarkFolderResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenameBookmarkFolderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenameBookmarkFolderResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenameBookmarkFolderResponseMultiError, or nil if none found.
+func (m *RenameBookmarkFolderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenameBookmarkFolderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFolder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RenameBookmarkFolderResponseValidationError{
+					field:  "Folder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RenameBookmarkFolderResponseValidationError{
+					field:  "Folder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFolder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RenameBookmarkFolderResponseValidationError{
+				field:  "Folder",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RenameBookmarkFolderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenameBookmarkFolderResponseMultiError is an error wrapping multiple
+// validation errors returned by RenameBookmarkFolderResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RenameBookmarkFolderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenameBookmarkFolderResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenameBookmarkFolderResponseMultiError) AllErrors() []error { return m }
+
+// RenameBookmarkFolderResponseValidationError is the validation error returned
+// by RenameBookmarkFolderResponse.Validate if the designated constraints
+// aren't met.
+type RenameBookmarkFolderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenameBookmarkFolderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenameBookmarkFolderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenameBookmarkFolderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenameBookmarkFolderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenameBookmarkFolderResponseValidationError) ErrorName() string {
+	return "RenameBookmarkFolderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenameBookmarkFolderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenameBookmarkFolderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenameBookmarkFolderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenameBookmarkFolderResponseValidationError{}
+
+// Validate checks the field values on DeleteBookmarkFolderRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBookmarkFolderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBookmarkFolderRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBookmarkFolderRequestMultiError, or nil if none found.
+func (m *DeleteBookmarkFolderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBookmarkFolderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = DeleteBookmarkFolderRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteBookmarkFolderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteBookmarkFolderRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteBookmarkFolderRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteBookmarkFolderRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteBookmarkFolderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBookmarkFolderRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBookmarkFolderRequestMultiError) AllErrors() []error { return m }
+
+// DeleteBookmarkFolderRequestValidationError is the validation error returned
+// by DeleteBookmarkFolderRequest.Validate if the designated constraints
+// aren't met.
+type DeleteBookmarkFolderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBookmarkFolderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBookmarkFolderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBookmarkFolderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBookmarkFolderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBookmarkFolderRequestValidationError) ErrorName() string {
+	return "DeleteBookmarkFolderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBookmarkFolderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBookmarkFolderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBookmarkFolderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBookmarkFolderRequestValidationError{}
+
+// Validate checks the field values on DeleteBookmarkFolderResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBookmarkFolderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBookmarkFolderResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBookmarkFolderResponseMultiError, or nil if none found.
+func (m *DeleteBookmarkFolderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBookmarkFolderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteBookmarkFolderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBookmarkFolderResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteBookmarkFolderResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteBookmarkFolderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBookmarkFolderResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBookmarkFolderResponseMultiError) AllErrors() []error { return m }
+
+// DeleteBookmarkFolderResponseValidationError is the validation error returned
+// by DeleteBookmarkFolderResponse.Validate if the designated constraints
+// aren't met.
+type DeleteBookmarkFolderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBookmarkFolderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBookmarkFolderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBookmarkFolderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBookmarkFolderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBookmarkFolderResponseValidationError) ErrorName() string {
+	return "DeleteBookmarkFolderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBookmarkFolderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBookmarkFolderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBookmarkFolderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBookmarkFolderResponseValidationError{}
+
+// Validate checks the field values on List with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on List with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ListMultiError, or nil if none found.
+func (m *List) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for OwnerId
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Private
+
+	// no validation rules for MemberCount
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMultiError is an error wrapping multiple validation errors returned by
+// List.ValidateAll() if the designated constraints aren't met.
+type ListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMultiError) AllErrors() []error { return m }
+
+// ListValidationError is the validation error returned by List.Validate if the
+// designated constraints aren't met.
+type ListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListValidationError) ErrorName() string { return "ListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListValidationError{}
+
+// Validate checks the field values on CreateListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateListRequestMultiError, or nil if none found.
+func (m *CreateListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 25 {
+		err := CreateListRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 25 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 100 {
+		err := CreateListRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Private
+
+	if len(errors) > 0 {
+		return CreateListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateListRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateListRequestMultiError) AllErrors() []error { return m }
+
+// CreateListRequestValidationError is the validation error returned by
+// CreateListRequest.Validate if the designated constraints aren't met.
+type CreateListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateListRequestValidationError) ErrorName() string {
+	return "CreateListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateListRequestValidationError{}
+
+// Validate checks the field values on CreateListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateListResponseMultiError, or nil if none found.
+func (m *CreateListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetList()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateListResponseValidationError{
+					field:  "List",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateListResponseValidationError{
+					field:  "List",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetList()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateListResponseValidationError{
+				field:  "List",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateListResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateListResponseMultiError) AllErrors() []error { return m }
+
+// CreateListResponseValidationError is the validation error returned by
+// CreateListResponse.Validate if the designated constraints aren't met.
+type CreateListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateListResponseValidationError) ErrorName() string {
+	return "CreateListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateListResponseValidationError{}
+
+// Validate checks the field values on GetListRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetListRequestMultiError,
+// or nil if none found.
+func (m *GetListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = GetListRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetListRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetListRequestMultiError is an error wrapping multiple validation errors
+// returned by GetListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListRequestMultiError) AllErrors() []error { return m }
+
+// GetListRequestValidationError is the validation error returned by
+// GetListRequest.Validate if the designated constraints aren't met.
+type GetListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListRequestValidationError) ErrorName() string { return "GetListRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListRequestValidationError{}
+
+// Validate checks the field values on GetListResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListResponseMultiError, or nil if none found.
+func (m *GetListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetList()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetListResponseValidationError{
+					field:  "List",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetListResponseValidationError{
+					field:  "List",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetList()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetListResponseValidationError{
+				field:  "List",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListResponseMultiError is an error wrapping multiple validation errors
+// returned by GetListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListResponseMultiError) AllErrors() []error { return m }
+
+// GetListResponseValidationError is the validation error returned by
+// GetListResponse.Validate if the designated constraints aren't met.
+type GetListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListResponseValidationError) ErrorName() string { return "GetListResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListResponseValidationError{}
+
+// Validate checks the field values on UpdateListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateListRequestMultiError, or nil if none found.
+func (m *UpdateListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = UpdateListRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 25 {
+		err := UpdateListRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 25 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 100 {
+		err := UpdateListRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Private
+
+	if len(errors) > 0 {
+		return UpdateListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateListRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateListRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateListRequestMultiError) AllErrors() []error { return m }
+
+// UpdateListRequestValidationError is the validation error returned by
+// UpdateListRequest.Validate if the designated constraints aren't met.
+type UpdateListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateListRequestValidationError) ErrorName() string {
+	return "UpdateListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateListRequestValidationError{}
+
+// Validate checks the field values on UpdateListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateListResponseMultiError, or nil if none found.
+func (m *UpdateListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetList()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateListResponseValidationError{
+					field:  "List",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateListResponseValidationError{
+					field:  "List",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetList()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateListResponseValidationError{
+				field:  "List",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateListResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdateListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateListResponseMultiError) AllErrors() []error { return m }
+
+// UpdateListResponseValidationError is the validation error returned by
+// UpdateListResponse.Validate if the designated constraints aren't met.
+type UpdateListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateListResponseValidationError) ErrorName() string {
+	return "UpdateListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateListResponseValidationError{}
+
+// Validate checks the field values on DeleteListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteListRequestMultiError, or nil if none found.
+func (m *DeleteListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = DeleteListRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteListRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteListRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteListRequestMultiError) AllErrors() []error { return m }
+
+// DeleteListRequestValidationError is the validation error returned by
+// DeleteListRequest.Validate if the designated constraints aren't met.
+type DeleteListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteListRequestValidationError) ErrorName() string {
+	return "DeleteListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteListRequestValidationError{}
+
+// Validate checks the field values on DeleteListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteListResponseMultiError, or nil if none found.
+func (m *DeleteListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteListResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteListResponseMultiError) AllErrors() []error { return m }
+
+// DeleteListResponseValidationError is the validation error returned by
+// DeleteListResponse.Validate if the designated constraints aren't met.
+type DeleteListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteListResponseValidationError) ErrorName() string {
+	return "DeleteListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteListResponseValidationError{}
+
+// Validate checks the field values on GetUserListsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserListsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserListsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserListsRequestMultiError, or nil if none found.
+func (m *GetUserListsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserListsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = GetUserListsRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserListsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetUserListsRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetUserListsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUserListsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserListsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserListsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserListsRequestMultiError) AllErrors() []error { return m }
+
+// GetUserListsRequestValidationError is the validation error returned by
+// GetUserListsRequest.Validate if the designated constraints aren't met.
+type GetUserListsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserListsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserListsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserListsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserListsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserListsRequestValidationError) ErrorName() string {
+	return "GetUserListsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserListsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserListsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserListsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserListsRequestValidationError{}
+
+// Validate checks the field values on GetUserListsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserListsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserListsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserListsResponseMultiError, or nil if none found.
+func (m *GetUserListsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserListsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLists() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUserListsResponseValidationError{
+						field:  fmt.Sprintf("Lists[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUserListsResponseValidationError{
+						field:  fmt.Sprintf("Lists[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUserListsResponseValidationError{
+					field:  fmt.Sprintf("Lists[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetUserListsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserListsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetUserListsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserListsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserListsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserListsResponseMultiError) AllErrors() []error { return m }
+
+// GetUserListsResponseValidationError is the validation error returned by
+// GetUserListsResponse.Validate if the designated constraints aren't met.
+type GetUserListsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserListsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserListsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserListsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserListsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserListsResponseValidationError) ErrorName() string {
+	return "GetUserListsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserListsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserListsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserListsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserListsResponseValidationError{}
+
+// Validate checks the field values on AddListMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddListMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddListMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddListMemberRequestMultiError, or nil if none found.
+func (m *AddListMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddListMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetListId()); err != nil {
+		err = AddListMemberRequestValidationError{
+			field:  "ListId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = AddListMemberRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddListMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AddListMemberRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// AddListMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by AddListMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddListMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddListMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddListMemberRequestMultiError) AllErrors() []error { return m }
+
+// AddListMemberRequestValidationError is the validation error returned by
+// AddListMemberRequest.Validate if the designated constraints aren't met.
+type AddListMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddListMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddListMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddListMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddListMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddListMemberRequestValidationError) ErrorName() string {
+	return "AddListMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddListMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddListMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddListMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddListMemberRequestValidationError{}
+
+// Validate checks the field values on AddListMemberResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddListMemberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddListMemberResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddListMemberResponseMultiError, or nil if none found.
+func (m *AddListMemberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddListMemberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AddListMemberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddListMemberResponseMultiError is an error wrapping multiple validation
+// errors returned by AddListMemberResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddListMemberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddListMemberResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddListMemberResponseMultiError) AllErrors() []error { return m }
+
+// AddListMemberResponseValidationError is the validation error returned by
+// AddListMemberResponse.Validate if the designated constraints aren't met.
+type AddListMemberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddListMemberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddListMemberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddListMemberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddListMemberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddListMemberResponseValidationError) ErrorName() string {
+	return "AddListMemberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddListMemberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddListMemberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddListMemberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddListMemberResponseValidationError{}
+
+// Validate checks the field values on RemoveListMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveListMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveListMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveListMemberRequestMultiError, or nil if none found.
+func (m *RemoveListMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveListMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetListId()); err != nil {
+		err = RemoveListMemberRequestValidationError{
+			field:  "ListId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = RemoveListMemberRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveListMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RemoveListMemberRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RemoveListMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveListMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveListMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveListMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveListMemberRequestMultiError) AllErrors() []error { return m }
+
+// RemoveListMemberRequestValidationError is the validation error returned by
+// RemoveListMemberRequest.Validate if the designated constraints aren't met.
+type RemoveListMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveListMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveListMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveListMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveListMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveListMemberRequestValidationError) ErrorName() string {
+	return "RemoveListMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveListMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveListMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveListMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveListMemberRequestValidationError{}
+
+// Validate checks the field values on RemoveListMemberResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveListMemberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveListMemberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveListMemberResponseMultiError, or nil if none found.
+func (m *RemoveListMemberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveListMemberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveListMemberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveListMemberResponseMultiError is an error wrapping multiple validation
+// errors returned by RemoveListMemberResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveListMemberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveListMemberResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveListMemberResponseMultiError) AllErrors() []error { return m }
+
+// RemoveListMemberResponseValidationError is the validation error returned by
+// RemoveListMemberResponse.Validate if the designated constraints aren't met.
+type RemoveListMemberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveListMemberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveListMemberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveListMemberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveListMemberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveListMemberResponseValidationError) ErrorName() string {
+	return "RemoveListMemberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveListMemberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveListMemberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveListMemberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveListMemberResponseValidationError{}
+
+// Validate checks the field values on GetListMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListMembersRequestMultiError, or nil if none found.
+func (m *GetListMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetListId()); err != nil {
+		err = GetListMembersRequestValidationError{
+			field:  "ListId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetListMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetListMembersRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetListMembersRequestMultiError is an error wrapping multiple validation
+// errors returned by GetListMembersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetListMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListMembersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListMembersRequestMultiError) AllErrors() []error { return m }
+
+// GetListMembersRequestValidationError is the validation error returned by
+// GetListMembersRequest.Validate if the designated constraints aren't met.
+type GetListMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListMembersRequestValidationError) ErrorName() string {
+	return "GetListMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListMembersRequestValidationError{}
+
+// Validate checks the field values on GetListMembersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListMembersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListMembersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListMembersResponseMultiError, or nil if none found.
+func (m *GetListMembersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListMembersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetListMembersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListMembersResponseMultiError is an error wrapping multiple validation
+// errors returned by GetListMembersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetListMembersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListMembersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListMembersResponseMultiError) AllErrors() []error { return m }
+
+// GetListMembersResponseValidationError is the validation error returned by
+// GetListMembersResponse.Validate if the designated constraints aren't met.
+type GetListMembersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListMembersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListMembersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListMembersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListMembersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListMembersResponseValidationError) ErrorName() string {
+	return "GetListMembersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListMembersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListMembersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListMembersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListMembersResponseValidationError{}
+
+// Validate checks the field values on GetListTimelineRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListTimelineRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListTimelineRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListTimelineRequestMultiError, or nil if none found.
+func (m *GetListTimelineRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListTimelineRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetListId()); err != nil {
+		err = GetListTimelineRequestValidationError{
+			field:  "ListId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := GetListTimelineRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return GetListTimelineRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetListTimelineRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetListTimelineRequestMultiError is an error wrapping multiple validation
+// errors returned by GetListTimelineRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetListTimelineRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListTimelineRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListTimelineRequestMultiError) AllErrors() []error { return m }
+
+// GetListTimelineRequestValidationError is the validation error returned by
+// GetListTimelineRequest.Validate if the designated constraints aren't met.
+type GetListTimelineRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListTimelineRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListTimelineRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListTimelineRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListTimelineRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListTimelineRequestValidationError) ErrorName() string {
+	return "GetListTimelineRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListTimelineRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListTimelineRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListTimelineRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListTimelineRequestValidationError{}
+
+// Validate checks the field values on GetListTimelineResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListTimelineResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListTimelineResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListTimelineResponseMultiError, or nil if none found.
+func (m *GetListTimelineResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListTimelineResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTweets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetListTimelineResponseValidationError{
+						field:  fmt.Sprintf("Tweets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetListTimelineResponseValidationError{
+						field:  fmt.Sprintf("Tweets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetListTimelineResponseValidationError{
+					field:  fmt.Sprintf("Tweets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return GetListTimelineResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListTimelineResponseMultiError is an error wrapping multiple validation
+// errors returned by GetListTimelineResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetListTimelineResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListTimelineResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListTimelineResponseMultiError) AllErrors() []error { return m }
+
+// GetListTimelineResponseValidationError is the validation error returned by
+// GetListTimelineResponse.Validate if the designated constraints aren't met.
+type GetListTimelineResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListTimelineResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListTimelineResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListTimelineResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListTimelineResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListTimelineResponseValidationError) ErrorName() string {
+	return "GetListTimelineResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListTimelineResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListTimelineResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListTimelineResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListTimelineResponseValidationError{}
+
+// Validate checks the field values on BlockRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BlockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BlockRequestMultiError, or
+// nil if none found.
+func (m *BlockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = BlockRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BlockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *BlockRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// BlockRequestMultiError is an error wrapping multiple validation errors
+// returned by BlockRequest.ValidateAll() if the designated constraints aren't met.
+type BlockRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockRequestMultiError) AllErrors() []error { return m }
+
+// BlockRequestValidationError is the validation error returned by
+// BlockRequest.Validate if the designated constraints aren't met.
+type BlockRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockRequestValidationError) ErrorName() string { return "BlockRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlockRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockRequestValidationError{}
+
+// Validate checks the field values on BlockResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BlockResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BlockResponseMultiError, or
+// nil if none found.
+func (m *BlockResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return BlockResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlockResponseMultiError is an error wrapping multiple validation errors
+// returned by BlockResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BlockResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockResponseMultiError) AllErrors() []error { return m }
+
+// BlockResponseValidationError is the validation error returned by
+// BlockResponse.Validate if the designated constraints aren't met.
+type BlockResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockResponseValidationError) ErrorName() string { return "BlockResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlockResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockResponseValidationError{}
+
+// Validate checks the field values on UnblockRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UnblockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnblockRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UnblockRequestMultiError,
+// or nil if none found.
+func (m *UnblockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnblockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = UnblockRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnblockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UnblockRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UnblockRequestMultiError is an error wrapping multiple validation errors
+// returned by UnblockRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UnblockRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnblockRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnblockRequestMultiError) AllErrors() []error { return m }
+
+// UnblockRequestValidationError is the validation error returned by
+// UnblockRequest.Validate if the designated constraints aren't met.
+type UnblockRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnblockRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnblockRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnblockRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnblockRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnblockRequestValidationError) ErrorName() string { return "UnblockRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnblockRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnblockRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnblockRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnblockRequestValidationError{}
+
+// Validate checks the field values on UnblockResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UnblockResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnblockResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnblockResponseMultiError, or nil if none found.
+func (m *UnblockResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnblockResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UnblockResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnblockResponseMultiError is an error wrapping multiple validation errors
+// returned by UnblockResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UnblockResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnblockResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnblockResponseMultiError) AllErrors() []error { return m }
+
+// UnblockResponseValidationError is the validation error returned by
+// UnblockResponse.Validate if the designated constraints aren't met.
+type UnblockResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnblockResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnblockResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnblockResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnblockResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnblockResponseValidationError) ErrorName() string { return "UnblockResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnblockResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnblockResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnblockResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnblockResponseValidationError{}
+
+// Validate checks the field values on MuteRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MuteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MuteRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MuteRequestMultiError, or
+// nil if none found.
+func (m *MuteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MuteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = MuteRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MuteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *MuteRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// MuteRequestMultiError is an error wrapping multiple validation errors
+// returned by MuteRequest.ValidateAll() if the designated constraints aren't met.
+type MuteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MuteRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MuteRequestMultiError) AllErrors() []error { return m }
+
+// MuteRequestValidationError is the validation error returned by
+// MuteRequest.Validate if the designated constraints aren't met.
+type MuteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MuteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MuteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MuteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MuteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MuteRequestValidationError) ErrorName() string { return "MuteRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MuteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMuteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MuteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MuteRequestValidationError{}
+
+// Validate checks the field values on MuteResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MuteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MuteResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MuteResponseMultiError, or
+// nil if none found.
+func (m *MuteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MuteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return MuteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MuteResponseMultiError is an error wrapping multiple validation errors
+// returned by MuteResponse.ValidateAll() if the designated constraints aren't met.
+type MuteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MuteResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MuteResponseMultiError) AllErrors() []error { return m }
+
+// MuteResponseValidationError is the validation error returned by
+// MuteResponse.Validate if the designated constraints aren't met.
+type MuteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MuteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MuteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MuteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MuteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MuteResponseValidationError) ErrorName() string { return "MuteResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MuteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMuteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MuteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MuteResponseValidationError{}
+
+// Validate checks the field values on UnmuteRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UnmuteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnmuteRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UnmuteRequestMultiError, or
+// nil if none found.
+func (m *UnmuteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnmuteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = UnmuteRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnmuteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UnmuteRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UnmuteRequestMultiError is an error wrapping multiple validation errors
+// returned by UnmuteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UnmuteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnmuteRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnmuteRequestMultiError) AllErrors() []error { return m }
+
+// UnmuteRequestValidationError is the validation error returned by
+// UnmuteRequest.Validate if the designated constraints aren't met.
+type UnmuteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnmuteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnmuteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnmuteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnmuteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnmuteRequestValidationError) ErrorName() string { return "UnmuteRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnmuteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnmuteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnmuteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnmuteRequestValidationError{}
+
+// Validate checks the field values on UnmuteResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UnmuteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnmuteResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UnmuteResponseMultiError,
+// or nil if none found.
+func (m *UnmuteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnmuteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UnmuteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnmuteResponseMultiError is an error wrapping multiple validation errors
+// returned by UnmuteResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UnmuteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnmuteResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnmuteResponseMultiError) AllErrors() []error { return m }
+
+// UnmuteResponseValidationError is the validation error returned by
+// UnmuteResponse.Validate if the designated constraints aren't met.
+type UnmuteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnmuteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnmuteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnmuteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnmuteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnmuteResponseValidationError) ErrorName() string { return "UnmuteResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnmuteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnmuteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnmuteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnmuteResponseValidationError{}
+
+// Validate checks the field values on AccessRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AccessRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AccessRequestMultiError, or
+// nil if none found.
+func (m *AccessRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReaderId
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessRequestValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessRequestValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessRequestValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AccessRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessRequestMultiError is an error wrapping multiple validation errors
+// returned by AccessRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AccessRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessRequestMultiError) AllErrors() []error { return m }
+
+// AccessRequestValidationError is the validation error returned by
+// AccessRequest.Validate if the designated constraints aren't met.
+type AccessRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessRequestValidationError) ErrorName() string { return "AccessRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AccessRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessRequestValidationError{}
+
+// Validate checks the field values on SetProtectedRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetProtectedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetProtectedRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetProtectedRequestMultiError, or nil if none found.
+func (m *SetProtectedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetProtectedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Protected
+
+	if len(errors) > 0 {
+		return SetProtectedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetProtectedRequestMultiError is an error wrapping multiple validation
+// errors returned by SetProtectedRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetProtectedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetProtectedRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetProtectedRequestMultiError) AllErrors() []error { return m }
+
+// SetProtectedRequestValidationError is the validation error returned by
+// SetProtectedRequest.Validate if the designated constraints aren't met.
+type SetProtectedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetProtectedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetProtectedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetProtectedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetProtectedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetProtectedRequestValidationError) ErrorName() string {
+	return "SetProtectedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetProtectedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetProtectedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetProtectedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetProtectedRequestValidationError{}
+
+// Validate checks the field values on SetProtectedResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetProtectedResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetProtectedResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetProtectedResponseMultiError, or nil if none found.
+func (m *SetProtectedResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetProtectedResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Protected
+
+	if len(errors) > 0 {
+		return SetProtectedResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetProtectedResponseMultiError is an error wrapping multiple validation
+// errors returned by SetProtectedResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetProtectedResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetProtectedResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetProtectedResponseMultiError) AllErrors() []error { return m }
+
+// SetProtectedResponseValidationError is the validation error returned by
+// SetProtectedResponse.Validate if the designated constraints aren't met.
+type SetProtectedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetProtectedResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetProtectedResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetProtectedResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetProtectedResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetProtectedResponseValidationError) ErrorName() string {
+	return "SetProtectedResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetProtectedResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetProtectedResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetProtectedResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetProtectedResponseValidationError{}
+
+// Validate checks the field values on RequestAccessRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestAccessRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestAccessRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestAccessRequestMultiError, or nil if none found.
+func (m *RequestAccessRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestAccessRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = RequestAccessRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RequestAccessRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RequestAccessRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RequestAccessRequestMultiError is an error wrapping multiple validation
+// errors returned by RequestAccessRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RequestAccessRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestAccessRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestAccessRequestMultiError) AllErrors() []error { return m }
+
+// RequestAccessRequestValidationError is the validation error returned by
+// RequestAccessRequest.Validate if the designated constraints aren't met.
+type RequestAccessRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestAccessRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestAccessRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestAccessRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestAccessRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestAccessRequestValidationError) ErrorName() string {
+	return "RequestAccessRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestAccessRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestAccessRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestAccessRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestAccessRequestValidationError{}
+
+// Validate checks the field values on RequestAccessResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestAccessResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestAccessResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestAccessResponseMultiError, or nil if none found.
+func (m *RequestAccessResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestAccessResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return RequestAccessResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestAccessResponseMultiError is an error wrapping multiple validation
+// errors returned by RequestAccessResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RequestAccessResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestAccessResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestAccessResponseMultiError) AllErrors() []error { return m }
+
+// RequestAccessResponseValidationError is the validation error returned by
+// RequestAccessResponse.Validate if the designated constraints aren't met.
+type RequestAccessResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestAccessResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestAccessResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestAccessResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestAccessResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestAccessResponseValidationError) ErrorName() string {
+	return "RequestAccessResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestAccessResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestAccessResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestAccessResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestAccessResponseValidationError{}
+
+// Validate checks the field values on ListAccessRequestsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAccessRequestsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAccessRequestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAccessRequestsRequestMultiError, or nil if none found.
+func (m *ListAccessRequestsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAccessRequestsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListAccessRequestsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAccessRequestsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAccessRequestsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListAccessRequestsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAccessRequestsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAccessRequestsRequestMultiError) AllErrors() []error { return m }
+
+// ListAccessRequestsRequestValidationError is the validation error returned by
+// ListAccessRequestsRequest.Validate if the designated constraints aren't met.
+type ListAccessRequestsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAccessRequestsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAccessRequestsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAccessRequestsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAccessRequestsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAccessRequestsRequestValidationError) ErrorName() string {
+	return "ListAccessRequestsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAccessRequestsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAccessRequestsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAccessRequestsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAccessRequestsRequestValidationError{}
+
+// Validate checks the field values on ListAccessRequestsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAccessRequestsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAccessRequestsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAccessRequestsResponseMultiError, or nil if none found.
+func (m *ListAccessRequestsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAccessRequestsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRequests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAccessRequestsResponseValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAccessRequestsResponseValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAccessRequestsResponseValidationError{
+					field:  fmt.Sprintf("Requests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListAccessRequestsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAccessRequestsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListAccessRequestsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListAccessRequestsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAccessRequestsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAccessRequestsResponseMultiError) AllErrors() []error { return m }
+
+// ListAccessRequestsResponseValidationError is the validation error returned
+// by ListAccessRequestsResponse.Validate if the designated constraints aren't met.
+type ListAccessRequestsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAccessRequestsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAccessRequestsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAccessRequestsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAccessRequestsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAccessRequestsResponseValidationError) ErrorName() string {
+	return "ListAccessRequestsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAccessRequestsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAccessRequestsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAccessRequestsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAccessRequestsResponseValidationError{}
+
+// Validate checks the field values on ApproveAccessRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveAccessRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveAccessRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveAccessRequestRequestMultiError, or nil if none found.
+func (m *ApproveAccessRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveAccessRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetReaderId()); err != nil {
+		err = ApproveAccessRequestRequestValidationError{
+			field:  "ReaderId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ApproveAccessRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ApproveAccessRequestRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ApproveAccessRequestRequestMultiError is an error wrapping multiple
+// validation errors returned by ApproveAccessRequestRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ApproveAccessRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveAccessRequestRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveAccessRequestRequestMultiError) AllErrors() []error { return m }
+
+// ApproveAccessRequestRequestValidationError is the validation error returned
+// by ApproveAccessRequestRequest.Validate if the designated constraints
+// aren't met.
+type ApproveAccessRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveAccessRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveAccessRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveAccessRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveAccessRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveAccessRequestRequestValidationError) ErrorName() string {
+	return "ApproveAccessRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveAccessRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveAccessRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveAccessRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveAccessRequestRequestValidationError{}
+
+// Validate checks the field values on ApproveAccessRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveAccessRequestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveAccessRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveAccessRequestResponseMultiError, or nil if none found.
+func (m *ApproveAccessRequestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveAccessRequestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ApproveAccessRequestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveAccessRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by ApproveAccessRequestResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ApproveAccessRequestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveAccessRequestResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveAccessRequestResponseMultiError) AllErrors() []error { return m }
+
+// ApproveAccessRequestResponseValidationError is the validation error returned
+// by ApproveAccessRequestResponse.Validate if the designated constraints
+// aren't met.
+type ApproveAccessRequestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveAccessRequestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveAccessRequestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveAccessRequestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveAccessRequestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveAccessRequestResponseValidationError) ErrorName() string {
+	return "ApproveAccessRequestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveAccessRequestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveAccessRequestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveAccessRequestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveAccessRequestResponseValidationError{}
+
+// Validate checks the field values on RevokeAccessRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeAccessRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeAccessRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeAccessRequestMultiError, or nil if none found.
+func (m *RevokeAccessRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeAccessRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetReaderId()); err != nil {
+		err = RevokeAccessRequestValidationError{
+			field:  "ReaderId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevokeAccessRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RevokeAccessRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RevokeAccessRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeAccessRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeAccessRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeAccessRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeAccessRequestMultiError) AllErrors() []error { return m }
+
+// RevokeAccessRequestValidationError is the validation error returned by
+// RevokeAccessRequest.Validate if the designated constraints aren't met.
+type RevokeAccessRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeAccessRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeAccessRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeAccessRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeAccessRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeAccessRequestValidationError) ErrorName() string {
+	return "RevokeAccessRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeAccessRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeAccessRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeAccessRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeAccessRequestValidationError{}
+
+// Validate checks the field values on RevokeAccessResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeAccessResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeAccessResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeAccessResponseMultiError, or nil if none found.
+func (m *RevokeAccessResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeAccessResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeAccessResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeAccessResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeAccessResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeAccessResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeAccessResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeAccessResponseMultiError) AllErrors() []error { return m }
+
+// RevokeAccessResponseValidationError is the validation error returned by
+// RevokeAccessResponse.Validate if the designated constraints aren't met.
+type RevokeAccessResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeAccessResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeAccessResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeAccessResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeAccessResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeAccessResponseValidationError) ErrorName() string {
+	return "RevokeAccessResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeAccessResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeAccessResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeAccessResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeAccessResponseValidationError{}
+
+// Validate checks the field values on Profile with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Profile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Profile with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ProfileMultiError, or nil if none found.
+func (m *Profile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Profile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Username
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Bio
+
+	// no validation rules for AvatarUrl
+
+	// no validation rules for Location
+
+	// no validation rules for Website
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProfileValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProfileValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProfileValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProfileValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProfileValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProfileValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ProfileMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProfileMultiError is an error wrapping multiple validation errors returned
+// by Profile.ValidateAll() if the designated constraints aren't met.
+type ProfileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProfileMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProfileMultiError) AllErrors() []error { return m }
+
+// ProfileValidationError is the validation error returned by Profile.Validate
+// if the designated constraints aren't met.
+type ProfileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProfileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProfileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProfileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProfileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProfileValidationError) ErrorName() string { return "ProfileValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProfileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProfile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProfileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProfileValidationError{}
+
+// Validate checks the field values on GetProfileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProfileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProfileRequestMultiError, or nil if none found.
+func (m *GetProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = GetProfileRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetProfileRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetProfileRequestMultiError is an error wrapping multiple validation errors
+// returned by GetProfileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProfileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProfileRequestMultiError) AllErrors() []error { return m }
+
+// GetProfileRequestValidationError is the validation error returned by
+// GetProfileRequest.Validate if the designated constraints aren't met.
+type GetProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProfileRequestValidationError) ErrorName() string {
+	return "GetProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProfileRequestValidationError{}
+
+// Validate checks the field values on GetProfileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProfileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProfileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProfileResponseMultiError, or nil if none found.
+func (m *GetProfileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProfileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetProfileResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetProfileResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetProfileResponseValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetProfileResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateTweetResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateTweetResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateTweetResponseMultiError []error
+// GetProfileResponseMultiError is an error wrapping multiple validation errors
+// returned by GetProfileResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetProfileResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateTweetResponseMultiError) Error() string {
+func (m GetProfileResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -999,11 +10545,11 @@ func (m UpdateTweetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateTweetResponseMultiError) AllErrors() []error { return m }
+func (m GetProfileResponseMultiError) AllErrors() []error { return m }
 
-// UpdateTweetResponseValidationError is the validation error returned by
-// UpdateTweetResponse.Validate if the designated constraints aren't met.
-type UpdateTweetResponseValidationError struct {
+// GetProfileResponseValidationError is the validation error returned by
+// GetProfileResponse.Validate if the designated constraints aren't met.
+type GetProfileResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1011,24 +10557,24 @@ type UpdateTweetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateTweetResponseValidationError) Field() string { return e.field }
+func (e GetProfileResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateTweetResponseValidationError) Reason() string { return e.reason }
+func (e GetProfileResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateTweetResponseValidationError) Cause() error { return e.cause }
+func (e GetProfileResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateTweetResponseValidationError) Key() bool { return e.key }
+func (e GetProfileResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateTweetResponseValidationError) ErrorName() string {
-	return "UpdateTweetResponseValidationError"
+func (e GetProfileResponseValidationError) ErrorName() string {
+	return "GetProfileResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateTweetResponseValidationError) Error() string {
+func (e GetProfileResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1040,14 +10586,14 @@ func (e UpdateTweetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateTweetResponse.%s: %s%s",
+		"invalid %sGetProfileResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateTweetResponseValidationError{}
+var _ error = GetProfileResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1055,35 +10601,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateTweetResponseValidationError{}
+} = GetProfileResponseValidationError{}
 
-// Validate checks the field values on DeleteTweetRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetProfileByUsernameRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteTweetRequest) Validate() error {
+func (m *GetProfileByUsernameRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteTweetRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetProfileByUsernameRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteTweetRequestMultiError, or nil if none found.
-func (m *DeleteTweetRequest) ValidateAll() error {
+// GetProfileByUsernameRequestMultiError, or nil if none found.
+func (m *GetProfileByUsernameRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteTweetRequest) validate(all bool) error {
+func (m *GetProfileByUsernameRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateUuid(m.GetId()); err != nil {
-		err = DeleteTweetRequestValidationError{
-			field:  "Id",
-			reason: "value must be a valid UUID",
-			cause:  err,
+	if !_GetProfileByUsernameRequest_Username_Pattern.MatchString(m.GetUsername()) {
+		err := GetProfileByUsernameRequestValidationError{
+			field:  "Username",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_]{1,15}$\"",
 		}
 		if !all {
 			return err
@@ -1092,27 +10637,153 @@ func (m *DeleteTweetRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DeleteTweetRequestMultiError(errors)
+		return GetProfileByUsernameRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *DeleteTweetRequest) _validateUuid(uuid string) error {
-	if matched := _service_uuidPattern.MatchString(uuid); !matched {
-		return errors.New("invalid uuid format")
+// GetProfileByUsernameRequestMultiError is an error wrapping multiple
+// validation errors returned by GetProfileByUsernameRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetProfileByUsernameRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProfileByUsernameRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProfileByUsernameRequestMultiError) AllErrors() []error { return m }
+
+// GetProfileByUsernameRequestValidationError is the validation error returned
+// by GetProfileByUsernameRequest.Validate if the designated constraints
+// aren't met.
+type GetProfileByUsernameRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProfileByUsernameRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProfileByUsernameRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProfileByUsernameRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProfileByUsernameRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProfileByUsernameRequestValidationError) ErrorName() string {
+	return "GetProfileByUsernameRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProfileByUsernameRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProfileByUsernameRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProfileByUsernameRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProfileByUsernameRequestValidationError{}
+
+var _GetProfileByUsernameRequest_Username_Pattern = regexp.MustCompile("^[A-Za-z0-9_]{1,15}$")
+
+// Validate checks the field values on GetProfileByUsernameResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProfileByUsernameResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProfileByUsernameResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProfileByUsernameResponseMultiError, or nil if none found.
+func (m *GetProfileByUsernameResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProfileByUsernameResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetProfileByUsernameResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetProfileByUsernameResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetProfileByUsernameResponseValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetProfileByUsernameResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteTweetRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteTweetRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteTweetRequestMultiError []error
+// GetProfileByUsernameResponseMultiError is an error wrapping multiple
+// validation errors returned by GetProfileByUsernameResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetProfileByUsernameResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteTweetRequestMultiError) Error() string {
+func (m GetProfileByUsernameResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1121,11 +10792,12 @@ func (m DeleteTweetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteTweetRequestMultiError) AllErrors() []error { return m }
+func (m GetProfileByUsernameResponseMultiError) AllErrors() []error { return m }
 
-// DeleteTweetRequestValidationError is the validation error returned by
-// DeleteTweetRequest.Validate if the designated constraints aren't met.
-type DeleteTweetRequestValidationError struct {
+// GetProfileByUsernameResponseValidationError is the validation error returned
+// by GetProfileByUsernameResponse.Validate if the designated constraints
+// aren't met.
+type GetProfileByUsernameResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1133,24 +10805,24 @@ type DeleteTweetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteTweetRequestValidationError) Field() string { return e.field }
+func (e GetProfileByUsernameResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteTweetRequestValidationError) Reason() string { return e.reason }
+func (e GetProfileByUsernameResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteTweetRequestValidationError) Cause() error { return e.cause }
+func (e GetProfileByUsernameResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteTweetRequestValidationError) Key() bool { return e.key }
+func (e GetProfileByUsernameResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteTweetRequestValidationError) ErrorName() string {
-	return "DeleteTweetRequestValidationError"
+func (e GetProfileByUsernameResponseValidationError) ErrorName() string {
+	return "GetProfileByUsernameResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteTweetRequestValidationError) Error() string {
+func (e GetProfileByUsernameResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1162,14 +10834,14 @@ func (e DeleteTweetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteTweetRequest.%s: %s%s",
+		"invalid %sGetProfileByUsernameResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteTweetRequestValidationError{}
+var _ error = GetProfileByUsernameResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1177,44 +10849,182 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteTweetRequestValidationError{}
+} = GetProfileByUsernameResponseValidationError{}
 
-// Validate checks the field values on DeleteTweetResponse with the rules
+// Validate checks the field values on UpdateProfileRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteTweetResponse) Validate() error {
+func (m *UpdateProfileRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteTweetResponse with the rules
+// ValidateAll checks the field values on UpdateProfileRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteTweetResponseMultiError, or nil if none found.
-func (m *DeleteTweetResponse) ValidateAll() error {
+// UpdateProfileRequestMultiError, or nil if none found.
+func (m *UpdateProfileRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteTweetResponse) validate(all bool) error {
-	if m == nil {
-		return nil
+func (m *UpdateProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_UpdateProfileRequest_Username_Pattern.MatchString(m.GetUsername()) {
+		err := UpdateProfileRequestValidationError{
+			field:  "Username",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_]{1,15}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDisplayName()) > 50 {
+		err := UpdateProfileRequestValidationError{
+			field:  "DisplayName",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetBio()) > 160 {
+		err := UpdateProfileRequestValidationError{
+			field:  "Bio",
+			reason: "value length must be at most 160 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAvatarUrl() != "" {
+
+		if utf8.RuneCountInString(m.GetAvatarUrl()) > 200 {
+			err := UpdateProfileRequestValidationError{
+				field:  "AvatarUrl",
+				reason: "value length must be at most 200 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if uri, err := url.Parse(m.GetAvatarUrl()); err != nil {
+			err = UpdateProfileRequestValidationError{
+				field:  "AvatarUrl",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := UpdateProfileRequestValidationError{
+				field:  "AvatarUrl",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_UpdateProfileRequest_AvatarUrl_Pattern.MatchString(m.GetAvatarUrl()) {
+			err := UpdateProfileRequestValidationError{
+				field:  "AvatarUrl",
+				reason: "value does not match regex pattern \"^https?://\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetLocation()) > 30 {
+		err := UpdateProfileRequestValidationError{
+			field:  "Location",
+			reason: "value length must be at most 30 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWebsite() != "" {
+
+		if utf8.RuneCountInString(m.GetWebsite()) > 100 {
+			err := UpdateProfileRequestValidationError{
+				field:  "Website",
+				reason: "value length must be at most 100 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if uri, err := url.Parse(m.GetWebsite()); err != nil {
+			err = UpdateProfileRequestValidationError{
+				field:  "Website",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := UpdateProfileRequestValidationError{
+				field:  "Website",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_UpdateProfileRequest_Website_Pattern.MatchString(m.GetWebsite()) {
+			err := UpdateProfileRequestValidationError{
+				field:  "Website",
+				reason: "value does not match regex pattern \"^https?://\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
 	}
 
-	var errors []error
-
 	if len(errors) > 0 {
-		return DeleteTweetResponseMultiError(errors)
+		return UpdateProfileRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteTweetResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteTweetResponse.ValidateAll() if the designated
+// UpdateProfileRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateProfileRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteTweetResponseMultiError []error
+type UpdateProfileRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteTweetResponseMultiError) Error() string {
+func (m UpdateProfileRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1223,11 +11033,11 @@ func (m DeleteTweetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteTweetResponseMultiError) AllErrors() []error { return m }
+func (m UpdateProfileRequestMultiError) AllErrors() []error { return m }
 
-// DeleteTweetResponseValidationError is the validation error returned by
-// DeleteTweetResponse.Validate if the designated constraints aren't met.
-type DeleteTweetResponseValidationError struct {
+// UpdateProfileRequestValidationError is the validation error returned by
+// UpdateProfileRequest.Validate if the designated constraints aren't met.
+type UpdateProfileRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1235,24 +11045,24 @@ type DeleteTweetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteTweetResponseValidationError) Field() string { return e.field }
+func (e UpdateProfileRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteTweetResponseValidationError) Reason() string { return e.reason }
+func (e UpdateProfileRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteTweetResponseValidationError) Cause() error { return e.cause }
+func (e UpdateProfileRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteTweetResponseValidationError) Key() bool { return e.key }
+func (e UpdateProfileRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteTweetResponseValidationError) ErrorName() string {
-	return "DeleteTweetResponseValidationError"
+func (e UpdateProfileRequestValidationError) ErrorName() string {
+	return "UpdateProfileRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteTweetResponseValidationError) Error() string {
+func (e UpdateProfileRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1264,14 +11074,14 @@ func (e DeleteTweetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteTweetResponse.%s: %s%s",
+		"invalid %sUpdateProfileRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteTweetResponseValidationError{}
+var _ error = UpdateProfileRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1279,44 +11089,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteTweetResponseValidationError{}
+} = UpdateProfileRequestValidationError{}
 
-// Validate checks the field values on GetSubscribersTweetsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+var _UpdateProfileRequest_Username_Pattern = regexp.MustCompile("^[A-Za-z0-9_]{1,15}$")
+
+var _UpdateProfileRequest_AvatarUrl_Pattern = regexp.MustCompile("^https?://")
+
+var _UpdateProfileRequest_Website_Pattern = regexp.MustCompile("^https?://")
+
+// Validate checks the field values on UpdateProfileResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetSubscribersTweetsRequest) Validate() error {
+func (m *UpdateProfileResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSubscribersTweetsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateProfileResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSubscribersTweetsRequestMultiError, or nil if none found.
-func (m *GetSubscribersTweetsRequest) ValidateAll() error {
+// UpdateProfileResponseMultiError, or nil if none found.
+func (m *UpdateProfileResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSubscribersTweetsRequest) validate(all bool) error {
+func (m *UpdateProfileResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateProfileResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateProfileResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateProfileResponseValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return GetSubscribersTweetsRequestMultiError(errors)
+		return UpdateProfileResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSubscribersTweetsRequestMultiError is an error wrapping multiple
-// validation errors returned by GetSubscribersTweetsRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GetSubscribersTweetsRequestMultiError []error
+// UpdateProfileResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateProfileResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateProfileResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSubscribersTweetsRequestMultiError) Error() string {
+func (m UpdateProfileResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1325,12 +11170,11 @@ func (m GetSubscribersTweetsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSubscribersTweetsRequestMultiError) AllErrors() []error { return m }
+func (m UpdateProfileResponseMultiError) AllErrors() []error { return m }
 
-// GetSubscribersTweetsRequestValidationError is the validation error returned
-// by GetSubscribersTweetsRequest.Validate if the designated constraints
-// aren't met.
-type GetSubscribersTweetsRequestValidationError struct {
+// UpdateProfileResponseValidationError is the validation error returned by
+// UpdateProfileResponse.Validate if the designated constraints aren't met.
+type UpdateProfileResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1338,24 +11182,24 @@ type GetSubscribersTweetsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSubscribersTweetsRequestValidationError) Field() string { return e.field }
+func (e UpdateProfileResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSubscribersTweetsRequestValidationError) Reason() string { return e.reason }
+func (e UpdateProfileResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSubscribersTweetsRequestValidationError) Cause() error { return e.cause }
+func (e UpdateProfileResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSubscribersTweetsRequestValidationError) Key() bool { return e.key }
+func (e UpdateProfileResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSubscribersTweetsRequestValidationError) ErrorName() string {
-	return "GetSubscribersTweetsRequestValidationError"
+func (e UpdateProfileResponseValidationError) ErrorName() string {
+	return "UpdateProfileResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetSubscribersTweetsRequestValidationError) Error() string {
+func (e UpdateProfileResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1367,14 +11211,14 @@ func (e GetSubscribersTweetsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSubscribersTweetsRequest.%s: %s%s",
+		"invalid %sUpdateProfileResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSubscribersTweetsRequestValidationError{}
+var _ error = UpdateProfileResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1382,78 +11226,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSubscribersTweetsRequestValidationError{}
+} = UpdateProfileResponseValidationError{}
 
-// Validate checks the field values on GetSubscribersTweetsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetConfigVersionRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetSubscribersTweetsResponse) Validate() error {
+func (m *GetConfigVersionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSubscribersTweetsResponse with the
+// ValidateAll checks the field values on GetConfigVersionRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSubscribersTweetsResponseMultiError, or nil if none found.
-func (m *GetSubscribersTweetsResponse) ValidateAll() error {
+// GetConfigVersionRequestMultiError, or nil if none found.
+func (m *GetConfigVersionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSubscribersTweetsResponse) validate(all bool) error {
+func (m *GetConfigVersionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetTweets() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetSubscribersTweetsResponseValidationError{
-						field:  fmt.Sprintf("Tweets[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetSubscribersTweetsResponseValidationError{
-						field:  fmt.Sprintf("Tweets[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetSubscribersTweetsResponseValidationError{
-					field:  fmt.Sprintf("Tweets[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return GetSubscribersTweetsResponseMultiError(errors)
+		return GetConfigVersionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSubscribersTweetsResponseMultiError is an error wrapping multiple
-// validation errors returned by GetSubscribersTweetsResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetSubscribersTweetsResponseMultiError []error
+// GetConfigVersionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetConfigVersionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetConfigVersionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSubscribersTweetsResponseMultiError) Error() string {
+func (m GetConfigVersionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1462,12 +11272,11 @@ func (m GetSubscribersTweetsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSubscribersTweetsResponseMultiError) AllErrors() []error { return m }
+func (m GetConfigVersionRequestMultiError) AllErrors() []error { return m }
 
-// GetSubscribersTweetsResponseValidationError is the validation error returned
-// by GetSubscribersTweetsResponse.Validate if the designated constraints
-// aren't met.
-type GetSubscribersTweetsResponseValidationError struct {
+// GetConfigVersionRequestValidationError is the validation error returned by
+// GetConfigVersionRequest.Validate if the designated constraints aren't met.
+type GetConfigVersionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1475,24 +11284,24 @@ type GetSubscribersTweetsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSubscribersTweetsResponseValidationError) Field() string { return e.field }
+func (e GetConfigVersionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSubscribersTweetsResponseValidationError) Reason() string { return e.reason }
+func (e GetConfigVersionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSubscribersTweetsResponseValidationError) Cause() error { return e.cause }
+func (e GetConfigVersionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSubscribersTweetsResponseValidationError) Key() bool { return e.key }
+func (e GetConfigVersionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSubscribersTweetsResponseValidationError) ErrorName() string {
-	return "GetSubscribersTweetsResponseValidationError"
+func (e GetConfigVersionRequestValidationError) ErrorName() string {
+	return "GetConfigVersionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetSubscribersTweetsResponseValidationError) Error() string {
+func (e GetConfigVersionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1504,14 +11313,14 @@ func (e GetSubscribersTweetsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSubscribersTweetsResponse.%s: %s%s",
+		"invalid %sGetConfigVersionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSubscribersTweetsResponseValidationError{}
+var _ error = GetConfigVersionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1519,143 +11328,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSubscribersTweetsResponseValidationError{}
+} = GetConfigVersionRequestValidationError{}
 
-// Validate checks the field values on Tweet with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Tweet) Validate() error {
+// Validate checks the field values on GetConfigVersionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetConfigVersionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Tweet with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in TweetMultiError, or nil if none found.
-func (m *Tweet) ValidateAll() error {
+// ValidateAll checks the field values on GetConfigVersionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetConfigVersionResponseMultiError, or nil if none found.
+func (m *GetConfigVersionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Tweet) validate(all bool) error {
+func (m *GetConfigVersionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateUuid(m.GetId()); err != nil {
-		err = TweetValidationError{
-			field:  "Id",
-			reason: "value must be a valid UUID",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if l := utf8.RuneCountInString(m.GetText()); l < 1 || l > 250 {
-		err := TweetValidationError{
-			field:  "Text",
-			reason: "value length must be between 1 and 250 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Version
 
-	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TweetValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TweetValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TweetValidationError{
-				field:  "CreatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Checksum
 
 	if all {
-		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		switch v := interface{}(m.GetLoadedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TweetValidationError{
-					field:  "UpdatedAt",
+				errors = append(errors, GetConfigVersionResponseValidationError{
+					field:  "LoadedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TweetValidationError{
-					field:  "UpdatedAt",
+				errors = append(errors, GetConfigVersionResponseValidationError{
+					field:  "LoadedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetLoadedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return TweetValidationError{
-				field:  "UpdatedAt",
+			return GetConfigVersionResponseValidationError{
+				field:  "LoadedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if err := m._validateUuid(m.GetUserId()); err != nil {
-		err = TweetValidationError{
-			field:  "UserId",
-			reason: "value must be a valid UUID",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return TweetMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *Tweet) _validateUuid(uuid string) error {
-	if matched := _service_uuidPattern.MatchString(uuid); !matched {
-		return errors.New("invalid uuid format")
+		return GetConfigVersionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TweetMultiError is an error wrapping multiple validation errors returned by
-// Tweet.ValidateAll() if the designated constraints aren't met.
-type TweetMultiError []error
+// GetConfigVersionResponseMultiError is an error wrapping multiple validation
+// errors returned by GetConfigVersionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetConfigVersionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TweetMultiError) Error() string {
+func (m GetConfigVersionResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1664,11 +11407,11 @@ func (m TweetMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TweetMultiError) AllErrors() []error { return m }
+func (m GetConfigVersionResponseMultiError) AllErrors() []error { return m }
 
-// TweetValidationError is the validation error returned by Tweet.Validate if
-// the designated constraints aren't met.
-type TweetValidationError struct {
+// GetConfigVersionResponseValidationError is the validation error returned by
+// GetConfigVersionResponse.Validate if the designated constraints aren't met.
+type GetConfigVersionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1676,22 +11419,24 @@ type TweetValidationError struct {
 }
 
 // Field function returns field value.
-func (e TweetValidationError) Field() string { return e.field }
+func (e GetConfigVersionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TweetValidationError) Reason() string { return e.reason }
+func (e GetConfigVersionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TweetValidationError) Cause() error { return e.cause }
+func (e GetConfigVersionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TweetValidationError) Key() bool { return e.key }
+func (e GetConfigVersionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TweetValidationError) ErrorName() string { return "TweetValidationError" }
+func (e GetConfigVersionResponseValidationError) ErrorName() string {
+	return "GetConfigVersionResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TweetValidationError) Error() string {
+func (e GetConfigVersionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1703,14 +11448,14 @@ func (e TweetValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTweet.%s: %s%s",
+		"invalid %sGetConfigVersionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TweetValidationError{}
+var _ error = GetConfigVersionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1718,4 +11463,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TweetValidationError{}
+} = GetConfigVersionResponseValidationError{}
@@ -21,10 +21,42 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	TwitterAPI_CreateTweet_FullMethodName          = "/api.proto.v1.TwitterAPI/CreateTweet"
 	TwitterAPI_GetTweetByID_FullMethodName         = "/api.proto.v1.TwitterAPI/GetTweetByID"
+	TwitterAPI_BatchGetTweets_FullMethodName       = "/api.proto.v1.TwitterAPI/BatchGetTweets"
 	TwitterAPI_GetUserTweets_FullMethodName        = "/api.proto.v1.TwitterAPI/GetUserTweets"
 	TwitterAPI_UpdateTweet_FullMethodName          = "/api.proto.v1.TwitterAPI/UpdateTweet"
 	TwitterAPI_DeleteTweet_FullMethodName          = "/api.proto.v1.TwitterAPI/DeleteTweet"
 	TwitterAPI_GetSubscribersTweets_FullMethodName = "/api.proto.v1.TwitterAPI/GetSubscribersTweets"
+	TwitterAPI_UploadMedia_FullMethodName          = "/api.proto.v1.TwitterAPI/UploadMedia"
+	TwitterAPI_VotePoll_FullMethodName             = "/api.proto.v1.TwitterAPI/VotePoll"
+	TwitterAPI_AddBookmark_FullMethodName          = "/api.proto.v1.TwitterAPI/AddBookmark"
+	TwitterAPI_RemoveBookmark_FullMethodName       = "/api.proto.v1.TwitterAPI/RemoveBookmark"
+	TwitterAPI_ListBookmarks_FullMethodName        = "/api.proto.v1.TwitterAPI/ListBookmarks"
+	TwitterAPI_CreateBookmarkFolder_FullMethodName = "/api.proto.v1.TwitterAPI/CreateBookmarkFolder"
+	TwitterAPI_ListBookmarkFolders_FullMethodName  = "/api.proto.v1.TwitterAPI/ListBookmarkFolders"
+	TwitterAPI_RenameBookmarkFolder_FullMethodName = "/api.proto.v1.TwitterAPI/RenameBookmarkFolder"
+	TwitterAPI_DeleteBookmarkFolder_FullMethodName = "/api.proto.v1.TwitterAPI/DeleteBookmarkFolder"
+	TwitterAPI_CreateList_FullMethodName           = "/api.proto.v1.TwitterAPI/CreateList"
+	TwitterAPI_GetList_FullMethodName              = "/api.proto.v1.TwitterAPI/GetList"
+	TwitterAPI_UpdateList_FullMethodName           = "/api.proto.v1.TwitterAPI/UpdateList"
+	TwitterAPI_DeleteList_FullMethodName           = "/api.proto.v1.TwitterAPI/DeleteList"
+	TwitterAPI_GetUserLists_FullMethodName         = "/api.proto.v1.TwitterAPI/GetUserLists"
+	TwitterAPI_AddListMember_FullMethodName        = "/api.proto.v1.TwitterAPI/AddListMember"
+	TwitterAPI_RemoveListMember_FullMethodName     = "/api.proto.v1.TwitterAPI/RemoveListMember"
+	TwitterAPI_GetListMembers_FullMethodName       = "/api.proto.v1.TwitterAPI/GetListMembers"
+	TwitterAPI_GetListTimeline_FullMethodName      = "/api.proto.v1.TwitterAPI/GetListTimeline"
+	TwitterAPI_Block_FullMethodName                = "/api.proto.v1.TwitterAPI/Block"
+	TwitterAPI_Unblock_FullMethodName              = "/api.proto.v1.TwitterAPI/Unblock"
+	TwitterAPI_Mute_FullMethodName                 = "/api.proto.v1.TwitterAPI/Mute"
+	TwitterAPI_Unmute_FullMethodName               = "/api.proto.v1.TwitterAPI/Unmute"
+	TwitterAPI_SetProtected_FullMethodName         = "/api.proto.v1.TwitterAPI/SetProtected"
+	TwitterAPI_RequestAccess_FullMethodName        = "/api.proto.v1.TwitterAPI/RequestAccess"
+	TwitterAPI_ListAccessRequests_FullMethodName   = "/api.proto.v1.TwitterAPI/ListAccessRequests"
+	TwitterAPI_ApproveAccessRequest_FullMethodName = "/api.proto.v1.TwitterAPI/ApproveAccessRequest"
+	TwitterAPI_RevokeAccess_FullMethodName         = "/api.proto.v1.TwitterAPI/RevokeAccess"
+	TwitterAPI_GetProfile_FullMethodName           = "/api.proto.v1.TwitterAPI/GetProfile"
+	TwitterAPI_GetProfileByUsername_FullMethodName = "/api.proto.v1.TwitterAPI/GetProfileByUsername"
+	TwitterAPI_UpdateProfile_FullMethodName        = "/api.proto.v1.TwitterAPI/UpdateProfile"
+	TwitterAPI_GetConfigVersion_FullMethodName     = "/api.proto.v1.TwitterAPI/GetConfigVersion"
 )
 
 // TwitterAPIClient is the client API for TwitterAPI service.
@@ -33,10 +65,42 @@ const (
 type TwitterAPIClient interface {
 	CreateTweet(ctx context.Context, in *CreateTweetRequest, opts ...grpc.CallOption) (*CreateTweetResponse, error)
 	GetTweetByID(ctx context.Context, in *GetTweetByIDRequest, opts ...grpc.CallOption) (*GetTweetByIDResponse, error)
+	BatchGetTweets(ctx context.Context, in *BatchGetTweetsRequest, opts ...grpc.CallOption) (*BatchGetTweetsResponse, error)
 	GetUserTweets(ctx context.Context, in *GetUserTweetsRequest, opts ...grpc.CallOption) (*GetUserTweetsResponse, error)
 	UpdateTweet(ctx context.Context, in *UpdateTweetRequest, opts ...grpc.CallOption) (*UpdateTweetResponse, error)
 	DeleteTweet(ctx context.Context, in *DeleteTweetRequest, opts ...grpc.CallOption) (*DeleteTweetResponse, error)
 	GetSubscribersTweets(ctx context.Context, in *GetSubscribersTweetsRequest, opts ...grpc.CallOption) (*GetSubscribersTweetsResponse, error)
+	UploadMedia(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadMediaRequest, UploadMediaResponse], error)
+	VotePoll(ctx context.Context, in *VotePollRequest, opts ...grpc.CallOption) (*VotePollResponse, error)
+	AddBookmark(ctx context.Context, in *AddBookmarkRequest, opts ...grpc.CallOption) (*AddBookmarkResponse, error)
+	RemoveBookmark(ctx context.Context, in *RemoveBookmarkRequest, opts ...grpc.CallOption) (*RemoveBookmarkResponse, error)
+	ListBookmarks(ctx context.Context, in *ListBookmarksRequest, opts ...grpc.CallOption) (*ListBookmarksResponse, error)
+	CreateBookmarkFolder(ctx context.Context, in *CreateBookmarkFolderRequest, opts ...grpc.CallOption) (*CreateBookmarkFolderResponse, error)
+	ListBookmarkFolders(ctx context.Context, in *ListBookmarkFoldersRequest, opts ...grpc.CallOption) (*ListBookmarkFoldersResponse, error)
+	RenameBookmarkFolder(ctx context.Context, in *RenameBookmarkFolderRequest, opts ...grpc.CallOption) (*RenameBookmarkFolderResponse, error)
+	DeleteBookmarkFolder(ctx context.Context, in *DeleteBookmarkFolderRequest, opts ...grpc.CallOption) (*DeleteBookmarkFolderResponse, error)
+	CreateList(ctx context.Context, in *CreateListRequest, opts ...grpc.CallOption) (*CreateListResponse, error)
+	GetList(ctx context.Context, in *GetListRequest, opts ...grpc.CallOption) (*GetListResponse, error)
+	UpdateList(ctx context.Context, in *UpdateListRequest, opts ...grpc.CallOption) (*UpdateListResponse, error)
+	DeleteList(ctx context.Context, in *DeleteListRequest, opts ...grpc.CallOption) (*DeleteListResponse, error)
+	GetUserLists(ctx context.Context, in *GetUserListsRequest, opts ...grpc.CallOption) (*GetUserListsResponse, error)
+	AddListMember(ctx context.Context, in *AddListMemberRequest, opts ...grpc.CallOption) (*AddListMemberResponse, error)
+	RemoveListMember(ctx context.Context, in *RemoveListMemberRequest, opts ...grpc.CallOption) (*RemoveListMemberResponse, error)
+	GetListMembers(ctx context.Context, in *GetListMembersRequest, opts ...grpc.CallOption) (*GetListMembersResponse, error)
+	GetListTimeline(ctx context.Context, in *GetListTimelineRequest, opts ...grpc.CallOption) (*GetListTimelineResponse, error)
+	Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	Unblock(ctx context.Context, in *UnblockRequest, opts ...grpc.CallOption) (*UnblockResponse, error)
+	Mute(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*MuteResponse, error)
+	Unmute(ctx context.Context, in *UnmuteRequest, opts ...grpc.CallOption) (*UnmuteResponse, error)
+	SetProtected(ctx context.Context, in *SetProtectedRequest, opts ...grpc.CallOption) (*SetProtectedResponse, error)
+	RequestAccess(ctx context.Context, in *RequestAccessRequest, opts ...grpc.CallOption) (*RequestAccessResponse, error)
+	ListAccessRequests(ctx context.Context, in *ListAccessRequestsRequest, opts ...grpc.CallOption) (*ListAccessRequestsResponse, error)
+	ApproveAccessRequest(ctx context.Context, in *ApproveAccessRequestRequest, opts ...grpc.CallOption) (*ApproveAccessRequestResponse, error)
+	RevokeAccess(ctx context.Context, in *RevokeAccessRequest, opts ...grpc.CallOption) (*RevokeAccessResponse, error)
+	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error)
+	GetProfileByUsername(ctx context.Context, in *GetProfileByUsernameRequest, opts ...grpc.CallOption) (*GetProfileByUsernameResponse, error)
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
+	GetConfigVersion(ctx context.Context, in *GetConfigVersionRequest, opts ...grpc.CallOption) (*GetConfigVersionResponse, error)
 }
 
 type twitterAPIClient struct {
@@ -67,6 +131,16 @@ func (c *twitterAPIClient) GetTweetByID(ctx context.Context, in *GetTweetByIDReq
 	return out, nil
 }
 
+func (c *twitterAPIClient) BatchGetTweets(ctx context.Context, in *BatchGetTweetsRequest, opts ...grpc.CallOption) (*BatchGetTweetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetTweetsResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_BatchGetTweets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *twitterAPIClient) GetUserTweets(ctx context.Context, in *GetUserTweetsRequest, opts ...grpc.CallOption) (*GetUserTweetsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUserTweetsResponse)
@@ -74,200 +148,1206 @@ func (c *twitterAPIClient) GetUserTweets(ctx context.Context, in *GetUserTweetsR
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *twitterAPIClient) UpdateTweet(ctx context.Context, in *UpdateTweetRequest, opts ...grpc.CallOption) (*UpdateTweetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTweetResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_UpdateTweet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) DeleteTweet(ctx context.Context, in *DeleteTweetRequest, opts ...grpc.CallOption) (*DeleteTweetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTweetResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_DeleteTweet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) GetSubscribersTweets(ctx context.Context, in *GetSubscribersTweetsRequest, opts ...grpc.CallOption) (*GetSubscribersTweetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSubscribersTweetsResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_GetSubscribersTweets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) UploadMedia(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadMediaRequest, UploadMediaResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TwitterAPI_ServiceDesc.Streams[0], TwitterAPI_UploadMedia_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadMediaRequest, UploadMediaResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TwitterAPI_UploadMediaClient = grpc.ClientStreamingClient[UploadMediaRequest, UploadMediaResponse]
+
+func (c *twitterAPIClient) VotePoll(ctx context.Context, in *VotePollRequest, opts ...grpc.CallOption) (*VotePollResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VotePollResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_VotePoll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) AddBookmark(ctx context.Context, in *AddBookmarkRequest, opts ...grpc.CallOption) (*AddBookmarkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddBookmarkResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_AddBookmark_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) RemoveBookmark(ctx context.Context, in *RemoveBookmarkRequest, opts ...grpc.CallOption) (*RemoveBookmarkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveBookmarkResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_RemoveBookmark_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) ListBookmarks(ctx context.Context, in *ListBookmarksRequest, opts ...grpc.CallOption) (*ListBookmarksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBookmarksResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_ListBookmarks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) CreateBookmarkFolder(ctx context.Context, in *CreateBookmarkFolderRequest, opts ...grpc.CallOption) (*CreateBookmarkFolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBookmarkFolderResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_CreateBookmarkFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) ListBookmarkFolders(ctx context.Context, in *ListBookmarkFoldersRequest, opts ...grpc.CallOption) (*ListBookmarkFoldersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBookmarkFoldersResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_ListBookmarkFolders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) RenameBookmarkFolder(ctx context.Context, in *RenameBookmarkFolderRequest, opts ...grpc.CallOption) (*RenameBookmarkFolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameBookmarkFolderResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_RenameBookmarkFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) DeleteBookmarkFolder(ctx context.Context, in *DeleteBookmarkFolderRequest, opts ...grpc.CallOption) (*DeleteBookmarkFolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBookmarkFolderResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_DeleteBookmarkFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) CreateList(ctx context.Context, in *CreateListRequest, opts ...grpc.CallOption) (*CreateListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateListResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_CreateList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) GetList(ctx context.Context, in *GetListRequest, opts ...grpc.CallOption) (*GetListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetListResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_GetList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) UpdateList(ctx context.Context, in *UpdateListRequest, opts ...grpc.CallOption) (*UpdateListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateListResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_UpdateList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) DeleteList(ctx context.Context, in *DeleteListRequest, opts ...grpc.CallOption) (*DeleteListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteListResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_DeleteList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) GetUserLists(ctx context.Context, in *GetUserListsRequest, opts ...grpc.CallOption) (*GetUserListsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserListsResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_GetUserLists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) AddListMember(ctx context.Context, in *AddListMemberRequest, opts ...grpc.CallOption) (*AddListMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddListMemberResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_AddListMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) RemoveListMember(ctx context.Context, in *RemoveListMemberRequest, opts ...grpc.CallOption) (*RemoveListMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveListMemberResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_RemoveListMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) GetListMembers(ctx context.Context, in *GetListMembersRequest, opts ...grpc.CallOption) (*GetListMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetListMembersResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_GetListMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) GetListTimeline(ctx context.Context, in *GetListTimelineRequest, opts ...grpc.CallOption) (*GetListTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetListTimelineResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_GetListTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_Block_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) Unblock(ctx context.Context, in *UnblockRequest, opts ...grpc.CallOption) (*UnblockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnblockResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_Unblock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) Mute(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*MuteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_Mute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) Unmute(ctx context.Context, in *UnmuteRequest, opts ...grpc.CallOption) (*UnmuteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnmuteResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_Unmute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) SetProtected(ctx context.Context, in *SetProtectedRequest, opts ...grpc.CallOption) (*SetProtectedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProtectedResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_SetProtected_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) RequestAccess(ctx context.Context, in *RequestAccessRequest, opts ...grpc.CallOption) (*RequestAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestAccessResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_RequestAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) ListAccessRequests(ctx context.Context, in *ListAccessRequestsRequest, opts ...grpc.CallOption) (*ListAccessRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccessRequestsResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_ListAccessRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) ApproveAccessRequest(ctx context.Context, in *ApproveAccessRequestRequest, opts ...grpc.CallOption) (*ApproveAccessRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveAccessRequestResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_ApproveAccessRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) RevokeAccess(ctx context.Context, in *RevokeAccessRequest, opts ...grpc.CallOption) (*RevokeAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAccessResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_RevokeAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProfileResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_GetProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) GetProfileByUsername(ctx context.Context, in *GetProfileByUsernameRequest, opts ...grpc.CallOption) (*GetProfileByUsernameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProfileByUsernameResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_GetProfileByUsername_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProfileResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_UpdateProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *twitterAPIClient) GetConfigVersion(ctx context.Context, in *GetConfigVersionRequest, opts ...grpc.CallOption) (*GetConfigVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConfigVersionResponse)
+	err := c.cc.Invoke(ctx, TwitterAPI_GetConfigVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TwitterAPIServer is the server API for TwitterAPI service.
+// All implementations should embed UnimplementedTwitterAPIServer
+// for forward compatibility.
+type TwitterAPIServer interface {
+	CreateTweet(context.Context, *CreateTweetRequest) (*CreateTweetResponse, error)
+	GetTweetByID(context.Context, *GetTweetByIDRequest) (*GetTweetByIDResponse, error)
+	BatchGetTweets(context.Context, *BatchGetTweetsRequest) (*BatchGetTweetsResponse, error)
+	GetUserTweets(context.Context, *GetUserTweetsRequest) (*GetUserTweetsResponse, error)
+	UpdateTweet(context.Context, *UpdateTweetRequest) (*UpdateTweetResponse, error)
+	DeleteTweet(context.Context, *DeleteTweetRequest) (*DeleteTweetResponse, error)
+	GetSubscribersTweets(context.Context, *GetSubscribersTweetsRequest) (*GetSubscribersTweetsResponse, error)
+	UploadMedia(grpc.ClientStreamingServer[UploadMediaRequest, UploadMediaResponse]) error
+	VotePoll(context.Context, *VotePollRequest) (*VotePollResponse, error)
+	AddBookmark(context.Context, *AddBookmarkRequest) (*AddBookmarkResponse, error)
+	RemoveBookmark(context.Context, *RemoveBookmarkRequest) (*RemoveBookmarkResponse, error)
+	ListBookmarks(context.Context, *ListBookmarksRequest) (*ListBookmarksResponse, error)
+	CreateBookmarkFolder(context.Context, *CreateBookmarkFolderRequest) (*CreateBookmarkFolderResponse, error)
+	ListBookmarkFolders(context.Context, *ListBookmarkFoldersRequest) (*ListBookmarkFoldersResponse, error)
+	RenameBookmarkFolder(context.Context, *RenameBookmarkFolderRequest) (*RenameBookmarkFolderResponse, error)
+	DeleteBookmarkFolder(context.Context, *DeleteBookmarkFolderRequest) (*DeleteBookmarkFolderResponse, error)
+	CreateList(context.Context, *CreateListRequest) (*CreateListResponse, error)
+	GetList(context.Context, *GetListRequest) (*GetListResponse, error)
+	UpdateList(context.Context, *UpdateListRequest) (*UpdateListResponse, error)
+	DeleteList(context.Context, *DeleteListRequest) (*DeleteListResponse, error)
+	GetUserLists(context.Context, *GetUserListsRequest) (*GetUserListsResponse, error)
+	AddListMember(context.Context, *AddListMemberRequest) (*AddListMemberResponse, error)
+	RemoveListMember(context.Context, *RemoveListMemberRequest) (*RemoveListMemberResponse, error)
+	GetListMembers(context.Context, *GetListMembersRequest) (*GetListMembersResponse, error)
+	GetListTimeline(context.Context, *GetListTimelineRequest) (*GetListTimelineResponse, error)
+	Block(context.Context, *BlockRequest) (*BlockResponse, error)
+	Unblock(context.Context, *UnblockRequest) (*UnblockResponse, error)
+	Mute(context.Context, *MuteRequest) (*MuteResponse, error)
+	Unmute(context.Context, *UnmuteRequest) (*UnmuteResponse, error)
+	SetProtected(context.Context, *SetProtectedRequest) (*SetProtectedResponse, error)
+	RequestAccess(context.Context, *RequestAccessRequest) (*RequestAccessResponse, error)
+	ListAccessRequests(context.Context, *ListAccessRequestsRequest) (*ListAccessRequestsResponse, error)
+	ApproveAccessRequest(context.Context, *ApproveAccessRequestRequest) (*ApproveAccessRequestResponse, error)
+	RevokeAccess(context.Context, *RevokeAccessRequest) (*RevokeAccessResponse, error)
+	GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error)
+	GetProfileByUsername(context.Context, *GetProfileByUsernameRequest) (*GetProfileByUsernameResponse, error)
+	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	GetConfigVersion(context.Context, *GetConfigVersionRequest) (*GetConfigVersionResponse, error)
+}
+
+// UnimplementedTwitterAPIServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTwitterAPIServer struct{}
+
+func (UnimplementedTwitterAPIServer) CreateTweet(context.Context, *CreateTweetRequest) (*CreateTweetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTweet not implemented")
+}
+func (UnimplementedTwitterAPIServer) GetTweetByID(context.Context, *GetTweetByIDRequest) (*GetTweetByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTweetByID not implemented")
+}
+func (UnimplementedTwitterAPIServer) BatchGetTweets(context.Context, *BatchGetTweetsRequest) (*BatchGetTweetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetTweets not implemented")
+}
+func (UnimplementedTwitterAPIServer) GetUserTweets(context.Context, *GetUserTweetsRequest) (*GetUserTweetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserTweets not implemented")
+}
+func (UnimplementedTwitterAPIServer) UpdateTweet(context.Context, *UpdateTweetRequest) (*UpdateTweetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTweet not implemented")
+}
+func (UnimplementedTwitterAPIServer) DeleteTweet(context.Context, *DeleteTweetRequest) (*DeleteTweetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTweet not implemented")
+}
+func (UnimplementedTwitterAPIServer) GetSubscribersTweets(context.Context, *GetSubscribersTweetsRequest) (*GetSubscribersTweetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubscribersTweets not implemented")
+}
+func (UnimplementedTwitterAPIServer) UploadMedia(grpc.ClientStreamingServer[UploadMediaRequest, UploadMediaResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadMedia not implemented")
+}
+func (UnimplementedTwitterAPIServer) VotePoll(context.Context, *VotePollRequest) (*VotePollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotePoll not implemented")
+}
+func (UnimplementedTwitterAPIServer) AddBookmark(context.Context, *AddBookmarkRequest) (*AddBookmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBookmark not implemented")
+}
+func (UnimplementedTwitterAPIServer) RemoveBookmark(context.Context, *RemoveBookmarkRequest) (*RemoveBookmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBookmark not implemented")
+}
+func (UnimplementedTwitterAPIServer) ListBookmarks(context.Context, *ListBookmarksRequest) (*ListBookmarksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookmarks not implemented")
+}
+func (UnimplementedTwitterAPIServer) CreateBookmarkFolder(context.Context, *CreateBookmarkFolderRequest) (*CreateBookmarkFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBookmarkFolder not implemented")
+}
+func (UnimplementedTwitterAPIServer) ListBookmarkFolders(context.Context, *ListBookmarkFoldersRequest) (*ListBookmarkFoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookmarkFolders not implemented")
+}
+func (UnimplementedTwitterAPIServer) RenameBookmarkFolder(context.Context, *RenameBookmarkFolderRequest) (*RenameBookmarkFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameBookmarkFolder not implemented")
+}
+func (UnimplementedTwitterAPIServer) DeleteBookmarkFolder(context.Context, *DeleteBookmarkFolderRequest) (*DeleteBookmarkFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBookmarkFolder not implemented")
+}
+func (UnimplementedTwitterAPIServer) CreateList(context.Context, *CreateListRequest) (*CreateListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateList not implemented")
+}
+func (UnimplementedTwitterAPIServer) GetList(context.Context, *GetListRequest) (*GetListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedTwitterAPIServer) UpdateList(context.Context, *UpdateListRequest) (*UpdateListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateList not implemented")
+}
+func (UnimplementedTwitterAPIServer) DeleteList(context.Context, *DeleteListRequest) (*DeleteListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteList not implemented")
+}
+func (UnimplementedTwitterAPIServer) GetUserLists(context.Context, *GetUserListsRequest) (*GetUserListsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserLists not implemented")
+}
+func (UnimplementedTwitterAPIServer) AddListMember(context.Context, *AddListMemberRequest) (*AddListMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddListMember not implemented")
+}
+func (UnimplementedTwitterAPIServer) RemoveListMember(context.Context, *RemoveListMemberRequest) (*RemoveListMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveListMember not implemented")
+}
+func (UnimplementedTwitterAPIServer) GetListMembers(context.Context, *GetListMembersRequest) (*GetListMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListMembers not implemented")
+}
+func (UnimplementedTwitterAPIServer) GetListTimeline(context.Context, *GetListTimelineRequest) (*GetListTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListTimeline not implemented")
+}
+func (UnimplementedTwitterAPIServer) Block(context.Context, *BlockRequest) (*BlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Block not implemented")
+}
+func (UnimplementedTwitterAPIServer) Unblock(context.Context, *UnblockRequest) (*UnblockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unblock not implemented")
+}
+func (UnimplementedTwitterAPIServer) Mute(context.Context, *MuteRequest) (*MuteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mute not implemented")
+}
+func (UnimplementedTwitterAPIServer) Unmute(context.Context, *UnmuteRequest) (*UnmuteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unmute not implemented")
+}
+func (UnimplementedTwitterAPIServer) SetProtected(context.Context, *SetProtectedRequest) (*SetProtectedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProtected not implemented")
+}
+func (UnimplementedTwitterAPIServer) RequestAccess(context.Context, *RequestAccessRequest) (*RequestAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAccess not implemented")
+}
+func (UnimplementedTwitterAPIServer) ListAccessRequests(context.Context, *ListAccessRequestsRequest) (*ListAccessRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessRequests not implemented")
+}
+func (UnimplementedTwitterAPIServer) ApproveAccessRequest(context.Context, *ApproveAccessRequestRequest) (*ApproveAccessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAccessRequest not implemented")
+}
+func (UnimplementedTwitterAPIServer) RevokeAccess(context.Context, *RevokeAccessRequest) (*RevokeAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAccess not implemented")
+}
+func (UnimplementedTwitterAPIServer) GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
+}
+func (UnimplementedTwitterAPIServer) GetProfileByUsername(context.Context, *GetProfileByUsernameRequest) (*GetProfileByUsernameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfileByUsername not implemented")
+}
+func (UnimplementedTwitterAPIServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (UnimplementedTwitterAPIServer) GetConfigVersion(context.Context, *GetConfigVersionRequest) (*GetConfigVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigVersion not implemented")
+}
+func (UnimplementedTwitterAPIServer) testEmbeddedByValue() {}
+
+// UnsafeTwitterAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TwitterAPIServer will
+// result in compilation errors.
+type UnsafeTwitterAPIServer interface {
+	mustEmbedUnimplementedTwitterAPIServer()
+}
+
+func RegisterTwitterAPIServer(s grpc.ServiceRegistrar, srv TwitterAPIServer) {
+	// If the following call pancis, it indicates UnimplementedTwitterAPIServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TwitterAPI_ServiceDesc, srv)
+}
+
+func _TwitterAPI_CreateTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTweetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).CreateTweet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_CreateTweet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).CreateTweet(ctx, req.(*CreateTweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TwitterAPI_GetTweetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTweetByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).GetTweetByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_GetTweetByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).GetTweetByID(ctx, req.(*GetTweetByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TwitterAPI_BatchGetTweets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetTweetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).BatchGetTweets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_BatchGetTweets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).BatchGetTweets(ctx, req.(*BatchGetTweetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TwitterAPI_GetUserTweets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserTweetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).GetUserTweets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_GetUserTweets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).GetUserTweets(ctx, req.(*GetUserTweetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TwitterAPI_UpdateTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTweetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).UpdateTweet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_UpdateTweet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).UpdateTweet(ctx, req.(*UpdateTweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TwitterAPI_DeleteTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTweetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).DeleteTweet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_DeleteTweet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).DeleteTweet(ctx, req.(*DeleteTweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TwitterAPI_GetSubscribersTweets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubscribersTweetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).GetSubscribersTweets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_GetSubscribersTweets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).GetSubscribersTweets(ctx, req.(*GetSubscribersTweetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TwitterAPI_UploadMedia_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TwitterAPIServer).UploadMedia(&grpc.GenericServerStream[UploadMediaRequest, UploadMediaResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TwitterAPI_UploadMediaServer = grpc.ClientStreamingServer[UploadMediaRequest, UploadMediaResponse]
+
+func _TwitterAPI_VotePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VotePollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).VotePoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_VotePoll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).VotePoll(ctx, req.(*VotePollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TwitterAPI_AddBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).AddBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_AddBookmark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).AddBookmark(ctx, req.(*AddBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TwitterAPI_RemoveBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).RemoveBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_RemoveBookmark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).RemoveBookmark(ctx, req.(*RemoveBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TwitterAPI_ListBookmarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookmarksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).ListBookmarks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_ListBookmarks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).ListBookmarks(ctx, req.(*ListBookmarksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TwitterAPI_CreateBookmarkFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBookmarkFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).CreateBookmarkFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_CreateBookmarkFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).CreateBookmarkFolder(ctx, req.(*CreateBookmarkFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TwitterAPI_ListBookmarkFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookmarkFoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).ListBookmarkFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_ListBookmarkFolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).ListBookmarkFolders(ctx, req.(*ListBookmarkFoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TwitterAPI_RenameBookmarkFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameBookmarkFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).RenameBookmarkFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_RenameBookmarkFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).RenameBookmarkFolder(ctx, req.(*RenameBookmarkFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TwitterAPI_DeleteBookmarkFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBookmarkFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).DeleteBookmarkFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_DeleteBookmarkFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).DeleteBookmarkFolder(ctx, req.(*DeleteBookmarkFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TwitterAPI_CreateList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).CreateList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_CreateList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).CreateList(ctx, req.(*CreateListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TwitterAPI_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_GetList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).GetList(ctx, req.(*GetListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TwitterAPI_UpdateList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).UpdateList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_UpdateList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).UpdateList(ctx, req.(*UpdateListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TwitterAPI_DeleteList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).DeleteList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_DeleteList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).DeleteList(ctx, req.(*DeleteListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TwitterAPI_GetUserLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserListsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).GetUserLists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_GetUserLists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).GetUserLists(ctx, req.(*GetUserListsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TwitterAPI_AddListMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddListMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).AddListMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_AddListMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).AddListMember(ctx, req.(*AddListMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TwitterAPI_RemoveListMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveListMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).RemoveListMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_RemoveListMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).RemoveListMember(ctx, req.(*RemoveListMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *twitterAPIClient) UpdateTweet(ctx context.Context, in *UpdateTweetRequest, opts ...grpc.CallOption) (*UpdateTweetResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UpdateTweetResponse)
-	err := c.cc.Invoke(ctx, TwitterAPI_UpdateTweet_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _TwitterAPI_GetListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListMembersRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).GetListMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_GetListMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).GetListMembers(ctx, req.(*GetListMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *twitterAPIClient) DeleteTweet(ctx context.Context, in *DeleteTweetRequest, opts ...grpc.CallOption) (*DeleteTweetResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteTweetResponse)
-	err := c.cc.Invoke(ctx, TwitterAPI_DeleteTweet_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _TwitterAPI_GetListTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListTimelineRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).GetListTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_GetListTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).GetListTimeline(ctx, req.(*GetListTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *twitterAPIClient) GetSubscribersTweets(ctx context.Context, in *GetSubscribersTweetsRequest, opts ...grpc.CallOption) (*GetSubscribersTweetsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetSubscribersTweetsResponse)
-	err := c.cc.Invoke(ctx, TwitterAPI_GetSubscribersTweets_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _TwitterAPI_Block_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).Block(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_Block_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).Block(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// TwitterAPIServer is the server API for TwitterAPI service.
-// All implementations should embed UnimplementedTwitterAPIServer
-// for forward compatibility.
-type TwitterAPIServer interface {
-	CreateTweet(context.Context, *CreateTweetRequest) (*CreateTweetResponse, error)
-	GetTweetByID(context.Context, *GetTweetByIDRequest) (*GetTweetByIDResponse, error)
-	GetUserTweets(context.Context, *GetUserTweetsRequest) (*GetUserTweetsResponse, error)
-	UpdateTweet(context.Context, *UpdateTweetRequest) (*UpdateTweetResponse, error)
-	DeleteTweet(context.Context, *DeleteTweetRequest) (*DeleteTweetResponse, error)
-	GetSubscribersTweets(context.Context, *GetSubscribersTweetsRequest) (*GetSubscribersTweetsResponse, error)
+func _TwitterAPI_Unblock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).Unblock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_Unblock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).Unblock(ctx, req.(*UnblockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedTwitterAPIServer should be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedTwitterAPIServer struct{}
-
-func (UnimplementedTwitterAPIServer) CreateTweet(context.Context, *CreateTweetRequest) (*CreateTweetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTweet not implemented")
-}
-func (UnimplementedTwitterAPIServer) GetTweetByID(context.Context, *GetTweetByIDRequest) (*GetTweetByIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTweetByID not implemented")
-}
-func (UnimplementedTwitterAPIServer) GetUserTweets(context.Context, *GetUserTweetsRequest) (*GetUserTweetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserTweets not implemented")
-}
-func (UnimplementedTwitterAPIServer) UpdateTweet(context.Context, *UpdateTweetRequest) (*UpdateTweetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTweet not implemented")
+func _TwitterAPI_Mute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).Mute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_Mute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).Mute(ctx, req.(*MuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTwitterAPIServer) DeleteTweet(context.Context, *DeleteTweetRequest) (*DeleteTweetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTweet not implemented")
+
+func _TwitterAPI_Unmute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).Unmute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_Unmute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).Unmute(ctx, req.(*UnmuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTwitterAPIServer) GetSubscribersTweets(context.Context, *GetSubscribersTweetsRequest) (*GetSubscribersTweetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSubscribersTweets not implemented")
+
+func _TwitterAPI_SetProtected_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProtectedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).SetProtected(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_SetProtected_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).SetProtected(ctx, req.(*SetProtectedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTwitterAPIServer) testEmbeddedByValue() {}
 
-// UnsafeTwitterAPIServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to TwitterAPIServer will
-// result in compilation errors.
-type UnsafeTwitterAPIServer interface {
-	mustEmbedUnimplementedTwitterAPIServer()
+func _TwitterAPI_RequestAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).RequestAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_RequestAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).RequestAccess(ctx, req.(*RequestAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterTwitterAPIServer(s grpc.ServiceRegistrar, srv TwitterAPIServer) {
-	// If the following call pancis, it indicates UnimplementedTwitterAPIServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _TwitterAPI_ListAccessRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&TwitterAPI_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(TwitterAPIServer).ListAccessRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TwitterAPI_ListAccessRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TwitterAPIServer).ListAccessRequests(ctx, req.(*ListAccessRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _TwitterAPI_CreateTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTweetRequest)
+func _TwitterAPI_ApproveAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveAccessRequestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TwitterAPIServer).CreateTweet(ctx, in)
+		return srv.(TwitterAPIServer).ApproveAccessRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TwitterAPI_CreateTweet_FullMethodName,
+		FullMethod: TwitterAPI_ApproveAccessRequest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TwitterAPIServer).CreateTweet(ctx, req.(*CreateTweetRequest))
+		return srv.(TwitterAPIServer).ApproveAccessRequest(ctx, req.(*ApproveAccessRequestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TwitterAPI_GetTweetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTweetByIDRequest)
+func _TwitterAPI_RevokeAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAccessRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TwitterAPIServer).GetTweetByID(ctx, in)
+		return srv.(TwitterAPIServer).RevokeAccess(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TwitterAPI_GetTweetByID_FullMethodName,
+		FullMethod: TwitterAPI_RevokeAccess_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TwitterAPIServer).GetTweetByID(ctx, req.(*GetTweetByIDRequest))
+		return srv.(TwitterAPIServer).RevokeAccess(ctx, req.(*RevokeAccessRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TwitterAPI_GetUserTweets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserTweetsRequest)
+func _TwitterAPI_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TwitterAPIServer).GetUserTweets(ctx, in)
+		return srv.(TwitterAPIServer).GetProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TwitterAPI_GetUserTweets_FullMethodName,
+		FullMethod: TwitterAPI_GetProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TwitterAPIServer).GetUserTweets(ctx, req.(*GetUserTweetsRequest))
+		return srv.(TwitterAPIServer).GetProfile(ctx, req.(*GetProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TwitterAPI_UpdateTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTweetRequest)
+func _TwitterAPI_GetProfileByUsername_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileByUsernameRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TwitterAPIServer).UpdateTweet(ctx, in)
+		return srv.(TwitterAPIServer).GetProfileByUsername(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TwitterAPI_UpdateTweet_FullMethodName,
+		FullMethod: TwitterAPI_GetProfileByUsername_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TwitterAPIServer).UpdateTweet(ctx, req.(*UpdateTweetRequest))
+		return srv.(TwitterAPIServer).GetProfileByUsername(ctx, req.(*GetProfileByUsernameRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TwitterAPI_DeleteTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTweetRequest)
+func _TwitterAPI_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TwitterAPIServer).DeleteTweet(ctx, in)
+		return srv.(TwitterAPIServer).UpdateProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TwitterAPI_DeleteTweet_FullMethodName,
+		FullMethod: TwitterAPI_UpdateProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TwitterAPIServer).DeleteTweet(ctx, req.(*DeleteTweetRequest))
+		return srv.(TwitterAPIServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TwitterAPI_GetSubscribersTweets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSubscribersTweetsRequest)
+func _TwitterAPI_GetConfigVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigVersionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TwitterAPIServer).GetSubscribersTweets(ctx, in)
+		return srv.(TwitterAPIServer).GetConfigVersion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TwitterAPI_GetSubscribersTweets_FullMethodName,
+		FullMethod: TwitterAPI_GetConfigVersion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TwitterAPIServer).GetSubscribersTweets(ctx, req.(*GetSubscribersTweetsRequest))
+		return srv.(TwitterAPIServer).GetConfigVersion(ctx, req.(*GetConfigVersionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -287,6 +1367,10 @@ var TwitterAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTweetByID",
 			Handler:    _TwitterAPI_GetTweetByID_Handler,
 		},
+		{
+			MethodName: "BatchGetTweets",
+			Handler:    _TwitterAPI_BatchGetTweets_Handler,
+		},
 		{
 			MethodName: "GetUserTweets",
 			Handler:    _TwitterAPI_GetUserTweets_Handler,
@@ -303,7 +1387,133 @@ var TwitterAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSubscribersTweets",
 			Handler:    _TwitterAPI_GetSubscribersTweets_Handler,
 		},
+		{
+			MethodName: "VotePoll",
+			Handler:    _TwitterAPI_VotePoll_Handler,
+		},
+		{
+			MethodName: "AddBookmark",
+			Handler:    _TwitterAPI_AddBookmark_Handler,
+		},
+		{
+			MethodName: "RemoveBookmark",
+			Handler:    _TwitterAPI_RemoveBookmark_Handler,
+		},
+		{
+			MethodName: "ListBookmarks",
+			Handler:    _TwitterAPI_ListBookmarks_Handler,
+		},
+		{
+			MethodName: "CreateBookmarkFolder",
+			Handler:    _TwitterAPI_CreateBookmarkFolder_Handler,
+		},
+		{
+			MethodName: "ListBookmarkFolders",
+			Handler:    _TwitterAPI_ListBookmarkFolders_Handler,
+		},
+		{
+			MethodName: "RenameBookmarkFolder",
+			Handler:    _TwitterAPI_RenameBookmarkFolder_Handler,
+		},
+		{
+			MethodName: "DeleteBookmarkFolder",
+			Handler:    _TwitterAPI_DeleteBookmarkFolder_Handler,
+		},
+		{
+			MethodName: "CreateList",
+			Handler:    _TwitterAPI_CreateList_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _TwitterAPI_GetList_Handler,
+		},
+		{
+			MethodName: "UpdateList",
+			Handler:    _TwitterAPI_UpdateList_Handler,
+		},
+		{
+			MethodName: "DeleteList",
+			Handler:    _TwitterAPI_DeleteList_Handler,
+		},
+		{
+			MethodName: "GetUserLists",
+			Handler:    _TwitterAPI_GetUserLists_Handler,
+		},
+		{
+			MethodName: "AddListMember",
+			Handler:    _TwitterAPI_AddListMember_Handler,
+		},
+		{
+			MethodName: "RemoveListMember",
+			Handler:    _TwitterAPI_RemoveListMember_Handler,
+		},
+		{
+			MethodName: "GetListMembers",
+			Handler:    _TwitterAPI_GetListMembers_Handler,
+		},
+		{
+			MethodName: "GetListTimeline",
+			Handler:    _TwitterAPI_GetListTimeline_Handler,
+		},
+		{
+			MethodName: "Block",
+			Handler:    _TwitterAPI_Block_Handler,
+		},
+		{
+			MethodName: "Unblock",
+			Handler:    _TwitterAPI_Unblock_Handler,
+		},
+		{
+			MethodName: "Mute",
+			Handler:    _TwitterAPI_Mute_Handler,
+		},
+		{
+			MethodName: "Unmute",
+			Handler:    _TwitterAPI_Unmute_Handler,
+		},
+		{
+			MethodName: "SetProtected",
+			Handler:    _TwitterAPI_SetProtected_Handler,
+		},
+		{
+			MethodName: "RequestAccess",
+			Handler:    _TwitterAPI_RequestAccess_Handler,
+		},
+		{
+			MethodName: "ListAccessRequests",
+			Handler:    _TwitterAPI_ListAccessRequests_Handler,
+		},
+		{
+			MethodName: "ApproveAccessRequest",
+			Handler:    _TwitterAPI_ApproveAccessRequest_Handler,
+		},
+		{
+			MethodName: "RevokeAccess",
+			Handler:    _TwitterAPI_RevokeAccess_Handler,
+		},
+		{
+			MethodName: "GetProfile",
+			Handler:    _TwitterAPI_GetProfile_Handler,
+		},
+		{
+			MethodName: "GetProfileByUsername",
+			Handler:    _TwitterAPI_GetProfileByUsername_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _TwitterAPI_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "GetConfigVersion",
+			Handler:    _TwitterAPI_GetConfigVersion_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadMedia",
+			Handler:       _TwitterAPI_UploadMedia_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/proto/v1/service.proto",
 }
@@ -0,0 +1,128 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	pb "twitter/api/proto/v1"
+	"twitter/cmd/back/internal/app"
+
+	"github.com/gofrs/uuid/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s GrpcServer) SetProtected(ctx context.Context, request *pb.SetProtectedRequest) (*pb.SetProtectedResponse, error) {
+
+	userId, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Database.SetProtectedToDB(ctx, uuid.FromStringOrNil(userId), request.Protected); err != nil {
+		return nil, fmt.Errorf("SetProtectedToDB: %w", err)
+	}
+
+	return &pb.SetProtectedResponse{Protected: request.Protected}, nil
+}
+
+func (s GrpcServer) RequestAccess(ctx context.Context, request *pb.RequestAccessRequest) (*pb.RequestAccessResponse, error) {
+
+	userId, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if request.UserId == userId {
+		return nil, status.Error(codes.InvalidArgument, "cannot request access to own account")
+	}
+	ownerId := uuid.FromStringOrNil(request.UserId)
+
+	protected, err := s.Database.IsProtectedFromDB(ctx, ownerId)
+	if err != nil {
+		return nil, fmt.Errorf("IsProtectedFromDB: %w", err)
+	}
+	if !protected {
+		return nil, status.Error(codes.FailedPrecondition, app.ErrNotProtected.Error())
+	}
+
+	accessRequest, err := s.Database.RequestAccessToDB(ctx, app.AccessRequest{
+		OwnerId:  ownerId,
+		ReaderId: uuid.FromStringOrNil(userId),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("RequestAccessToDB: %w", err)
+	}
+
+	return &pb.RequestAccessResponse{Status: toAccessStatus(accessRequest.Status)}, nil
+}
+
+func (s GrpcServer) ListAccessRequests(ctx context.Context, request *pb.ListAccessRequestsRequest) (*pb.ListAccessRequestsResponse, error) {
+
+	userId, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	requests, err := s.Database.GetAccessRequestsFromDB(ctx, uuid.FromStringOrNil(userId))
+	if err != nil {
+		return nil, fmt.Errorf("GetAccessRequestsFromDB: %w", err)
+	}
+	pbRequests := make([]*pb.AccessRequest, len(requests))
+	for i, r := range requests {
+		pbRequests[i] = &pb.AccessRequest{
+			ReaderId:  r.ReaderId.String(),
+			Status:    toAccessStatus(r.Status),
+			CreatedAt: timestamppb.New(r.CreatedAt),
+		}
+	}
+
+	return &pb.ListAccessRequestsResponse{Requests: pbRequests}, nil
+}
+
+func (s GrpcServer) ApproveAccessRequest(ctx context.Context, request *pb.ApproveAccessRequestRequest) (*pb.ApproveAccessRequestResponse, error) {
+
+	userId, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Database.ApproveAccessRequestToDB(ctx, uuid.FromStringOrNil(userId), uuid.FromStringOrNil(request.ReaderId))
+	if errors.Is(err, app.ErrAccessNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("ApproveAccessRequestToDB: %w", err)
+	}
+
+	return &pb.ApproveAccessRequestResponse{}, nil
+}
+
+func (s GrpcServer) RevokeAccess(ctx context.Context, request *pb.RevokeAccessRequest) (*pb.RevokeAccessResponse, error) {
+
+	userId, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Database.RevokeAccessFromDB(ctx, uuid.FromStringOrNil(userId), uuid.FromStringOrNil(request.ReaderId))
+	if err != nil {
+		return nil, fmt.Errorf("RevokeAccessFromDB: %w", err)
+	}
+
+	return &pb.RevokeAccessResponse{}, nil
+}
+
+func toAccessStatus(s string) pb.AccessStatus {
+	switch s {
+	case app.AccessPending:
+		return pb.AccessStatus_ACCESS_STATUS_PENDING
+	case app.AccessApproved:
+		return pb.AccessStatus_ACCESS_STATUS_APPROVED
+	}
+	return pb.AccessStatus_ACCESS_STATUS_NONE
+}
@@ -0,0 +1,28 @@
+package api
+
+import (
+	"context"
+	pb "twitter/api/proto/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s GrpcServer) GetConfigVersion(ctx context.Context, request *pb.GetConfigVersionRequest) (*pb.GetConfigVersionResponse, error) {
+
+	userId, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !s.Settings.IsAdmin(userId) {
+		return nil, status.Error(codes.PermissionDenied, "admin access required")
+	}
+
+	settings := s.Settings.Get()
+	return &pb.GetConfigVersionResponse{
+		Version:  settings.ConfigVersion,
+		Checksum: settings.ConfigChecksum,
+		LoadedAt: timestamppb.New(settings.ConfigLoadedAt),
+	}, nil
+}
@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	pb "twitter/api/proto/v1"
+	"twitter/cmd/back/internal/app"
+	"twitter/internal/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s GrpcServer) BatchGetTweets(ctx context.Context, request *pb.BatchGetTweetsRequest) (*pb.BatchGetTweetsResponse, error) {
+
+	if err := request.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ids := make([]uuid.UUID, len(request.Ids))
+	for i, id := range request.Ids {
+		ids[i] = uuid.FromStringOrNil(id)
+	}
+	found, err := s.getTweetsByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	tweets := make([]app.Tweet, 0, len(found))
+	for _, t := range found {
+		tweets = append(tweets, t)
+	}
+	pbTweets, err := s.presentTweets(ctx, tweets)
+	if err != nil {
+		return nil, err
+	}
+	byId := make(map[string]*pb.Tweet, len(pbTweets))
+	for _, t := range pbTweets {
+		byId[t.Id] = t
+	}
+
+	results := make([]*pb.BatchGetTweetsResult, len(request.Ids))
+	for i, id := range request.Ids {
+		tweet, ok := byId[ids[i].String()]
+		results[i] = &pb.BatchGetTweetsResult{Id: id, Tweet: tweet, NotFound: !ok}
+	}
+
+	return &pb.BatchGetTweetsResponse{Results: results}, nil
+}
+
+// getTweetsByIDs читает твиты сначала из кэша одним MGET, затем одним запросом добирает промахи
+// из базы и возвращает их в кэш. Отсутствующих твитов в ответе нет, повторы id схлопываются
+func (s GrpcServer) getTweetsByIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]app.Tweet, error) {
+	tweets := make(map[uuid.UUID]app.Tweet, len(ids))
+	if len(ids) == 0 {
+		return tweets, nil
+	}
+
+	unique := make([]uuid.UUID, 0, len(ids))
+	keys := make([]string, 0, len(ids))
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+			keys = append(keys, tweetKey(id))
+		}
+	}
+
+	cached, err := s.CacheDBTweets.GetMany(ctx, keys...)
+	if err != nil {
+		if err := s.cacheOutage(err); err != nil {
+			return nil, err
+		}
+		// кэш недоступен: читаем все из базы
+		logger.FromContext(ctx).WarnContext(ctx, "cache get failed", "cache", "tweets", "keys", len(keys), "error", err)
+	}
+
+	var misses []uuid.UUID
+	for i, key := range keys {
+		id := unique[i]
+		raw, ok := cached[key]
+		if !ok {
+			misses = append(misses, id)
+			continue
+		}
+		if raw == tweetNotFoundMarker {
+			continue
+		}
+		tweet, err := s.decodeTweet(ctx, key, raw)
+		if err != nil {
+			misses = append(misses, id)
+			continue
+		}
+		s.refreshPollVotes(ctx, tweet.Poll)
+		tweets[id] = tweet
+	}
+	if len(misses) == 0 {
+		return tweets, nil
+	}
+
+	fromDB, err := s.Database.GetTweetsByIDsFromDB(ctx, misses)
+	if err != nil {
+		return nil, fmt.Errorf("GetTweetsByIDsFromDB: %w", err)
+	}
+	if err := s.hydrateTweets(ctx, fromDB); err != nil {
+		return nil, fmt.Errorf("hydrateTweets: %w", err)
+	}
+
+	backfill := make(map[string][]byte, len(fromDB))
+	for _, t := range fromDB {
+		tweets[t.Id] = t
+		if data, ok := s.encodeTweet(ctx, t); ok {
+			backfill[tweetKey(t.Id)] = data
+		}
+	}
+	if len(backfill) > 0 {
+		if err := s.CacheDBTweets.SetManyVersioned(ctx, backfill, s.Settings.TweetCacheTTL()); err != nil {
+			logger.FromContext(ctx).WarnContext(ctx, "cache set failed", "cache", "tweets", "keys", len(backfill), "error", err)
+		}
+	}
+
+	return tweets, nil
+}
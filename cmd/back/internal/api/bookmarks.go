@@ -0,0 +1,252 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	pb "twitter/api/proto/v1"
+	"twitter/cmd/back/internal/app"
+	"twitter/internal/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const defaultBookmarksPageSize = 20
+
+func (s GrpcServer) AddBookmark(ctx context.Context, request *pb.AddBookmarkRequest) (*pb.AddBookmarkResponse, error) {
+
+	userId, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, err := s.visibleTweet(ctx, uuid.FromStringOrNil(request.TweetId)); err != nil {
+		return nil, err
+	}
+
+	bookmark := app.Bookmark{
+		UserId:   uuid.FromStringOrNil(userId),
+		TweetId:  uuid.FromStringOrNil(request.TweetId),
+		FolderId: toNullUUID(request.FolderId),
+	}
+	err = s.Database.AddBookmarkToDB(ctx, bookmark)
+	if errors.Is(err, app.ErrFolderNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("AddBookmarkToDB: %w", err)
+	}
+
+	return &pb.AddBookmarkResponse{}, nil
+}
+
+func (s GrpcServer) RemoveBookmark(ctx context.Context, request *pb.RemoveBookmarkRequest) (*pb.RemoveBookmarkResponse, error) {
+
+	userId, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bookmark := app.Bookmark{
+		UserId:  uuid.FromStringOrNil(userId),
+		TweetId: uuid.FromStringOrNil(request.TweetId),
+	}
+	if err := s.Database.DeleteBookmarkFromDB(ctx, bookmark); err != nil {
+		return nil, fmt.Errorf("DeleteBookmarkFromDB: %w", err)
+	}
+
+	return &pb.RemoveBookmarkResponse{}, nil
+}
+
+func (s GrpcServer) ListBookmarks(ctx context.Context, request *pb.ListBookmarksRequest) (*pb.ListBookmarksResponse, error) {
+
+	userId, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cursor, err := decodePageToken(request.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+	pageSize := int(request.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultBookmarksPageSize
+	}
+
+	// берем на одну запись больше, чтобы понять, есть ли следующая страница
+	bookmarks, err := s.Database.GetBookmarksFromDB(ctx, uuid.FromStringOrNil(userId), toNullUUID(request.FolderId),
+		cursor, pageSize+1)
+	if err != nil {
+		return nil, fmt.Errorf("GetBookmarksFromDB: %w", err)
+	}
+	var nextPageToken string
+	if len(bookmarks) > pageSize {
+		bookmarks = bookmarks[:pageSize]
+		last := bookmarks[len(bookmarks)-1]
+		nextPageToken = encodePageToken(app.PageCursor{CreatedAt: last.CreatedAt, TweetId: last.TweetId})
+	}
+
+	ids := make([]uuid.UUID, len(bookmarks))
+	for i := range bookmarks {
+		ids[i] = bookmarks[i].TweetId
+	}
+	byId, err := s.getTweetsByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	// твиты, удаленные до обработки события, просто пропускаются
+	ordered := make([]app.Tweet, 0, len(bookmarks))
+	for _, b := range bookmarks {
+		if t, ok := byId[b.TweetId]; ok {
+			ordered = append(ordered, t)
+		}
+	}
+	pbTweets, err := s.presentTweets(ctx, ordered)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListBookmarksResponse{Tweets: pbTweets, NextPageToken: nextPageToken}, nil
+}
+
+func (s GrpcServer) CreateBookmarkFolder(ctx context.Context, request *pb.CreateBookmarkFolderRequest) (*pb.CreateBookmarkFolderResponse, error) {
+
+	userId, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	folder, err := s.Database.CreateBookmarkFolderToDB(ctx, app.BookmarkFolder{
+		UserId: uuid.FromStringOrNil(userId),
+		Name:   request.Name,
+	})
+	if errors.Is(err, app.ErrFolderExists) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("CreateBookmarkFolderToDB: %w", err)
+	}
+
+	return &pb.CreateBookmarkFolderResponse{Folder: toBookmarkFolder(folder)}, nil
+}
+
+func (s GrpcServer) ListBookmarkFolders(ctx context.Context, request *pb.ListBookmarkFoldersRequest) (*pb.ListBookmarkFoldersResponse, error) {
+
+	userId, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	folders, err := s.Database.GetBookmarkFoldersFromDB(ctx, uuid.FromStringOrNil(userId))
+	if err != nil {
+		return nil, fmt.Errorf("GetBookmarkFoldersFromDB: %w", err)
+	}
+	pbFolders := make([]*pb.BookmarkFolder, len(folders))
+	for i := range folders {
+		pbFolders[i] = toBookmarkFolder(folders[i])
+	}
+
+	return &pb.ListBookmarkFoldersResponse{Folders: pbFolders}, nil
+}
+
+func (s GrpcServer) RenameBookmarkFolder(ctx context.Context, request *pb.RenameBookmarkFolderRequest) (*pb.RenameBookmarkFolderResponse, error) {
+
+	userId, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	folder, err := s.Database.RenameBookmarkFolderToDB(ctx, app.BookmarkFolder{
+		Id:     uuid.FromStringOrNil(request.Id),
+		UserId: uuid.FromStringOrNil(userId),
+		Name:   request.Name,
+	})
+	switch {
+	case errors.Is(err, app.ErrFolderNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, app.ErrFolderExists):
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	case err != nil:
+		return nil, fmt.Errorf("RenameBookmarkFolderToDB: %w", err)
+	}
+
+	return &pb.RenameBookmarkFolderResponse{Folder: toBookmarkFolder(folder)}, nil
+}
+
+func (s GrpcServer) DeleteBookmarkFolder(ctx context.Context, request *pb.DeleteBookmarkFolderRequest) (*pb.DeleteBookmarkFolderResponse, error) {
+
+	userId, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Database.DeleteBookmarkFolderFromDB(ctx, app.BookmarkFolder{
+		Id:     uuid.FromStringOrNil(request.Id),
+		UserId: uuid.FromStringOrNil(userId),
+	})
+	if errors.Is(err, app.ErrFolderNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("DeleteBookmarkFolderFromDB: %w", err)
+	}
+
+	return &pb.DeleteBookmarkFolderResponse{}, nil
+}
+
+// markBookmarked проставляет флаг bookmarked для текущего пользователя.
+// Ошибка не прерывает запрос: флаг просто останется false
+func (s GrpcServer) markBookmarked(ctx context.Context, tweets []*pb.Tweet) {
+	userId, err := GetUserIDFromContext(ctx)
+	if err != nil || len(tweets) == 0 {
+		return
+	}
+	ids := make([]uuid.UUID, len(tweets))
+	for i := range tweets {
+		ids[i] = uuid.FromStringOrNil(tweets[i].Id)
+	}
+	bookmarked, err := s.Database.GetBookmarkedTweetIDsFromDB(ctx, uuid.FromStringOrNil(userId), ids)
+	if err != nil {
+		logger.FromContext(ctx).ErrorContext(ctx, "load bookmarked flags failed", "error", err)
+		return
+	}
+	for i := range tweets {
+		tweets[i].Bookmarked = bookmarked[ids[i]]
+	}
+}
+
+func toBookmarkFolder(f app.BookmarkFolder) *pb.BookmarkFolder {
+	return &pb.BookmarkFolder{
+		Id:        f.Id.String(),
+		Name:      f.Name,
+		CreatedAt: timestamppb.New(f.CreatedAt),
+	}
+}
+
+func toNullUUID(s string) uuid.NullUUID {
+	if s == "" {
+		return uuid.NullUUID{}
+	}
+	return uuid.NullUUID{UUID: uuid.FromStringOrNil(s), Valid: true}
+}
@@ -0,0 +1,155 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	pb "twitter/api/proto/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// ifMatchMetadataKey ключ метаданных gRPC, в который gateway кладет заголовок If-Match
+const ifMatchMetadataKey = "if-match"
+
+// ETag твита состоит из его версии и хэша ответа: "<version>-<hash>". Хэш считается по телу
+// ответа конкретному читателю, поэтому ETag меняется и вместе с полями, которые живут отдельно
+// от версии: bookmarked, счетчиками опроса, профилем автора. Версия нужна для If-Match
+func formatETag(version int64, m proto.Message) (string, error) {
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(body)
+	return `"` + strconv.FormatInt(version, 10) + "-" + hex.EncodeToString(sum[:8]) + `"`, nil
+}
+
+// parseETag достает версию из сильного ETag, выданного formatETag. ETag из одной версии
+// тоже принимается
+func parseETag(etag string) (int64, bool) {
+	etag = strings.TrimSpace(etag)
+	if len(etag) < 2 || etag[0] != '"' || etag[len(etag)-1] != '"' {
+		return 0, false
+	}
+	value, _, _ := strings.Cut(etag[1:len(etag)-1], "-")
+	version, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, false
+	}
+	return version, true
+}
+
+// expectedVersion возвращает ожидаемую версию твита: из поля запроса, а если оно не задано,
+// из If-Match. Ноль означает обновление без проверки версии
+func expectedVersion(ctx context.Context, fromRequest int64) (int64, error) {
+	if fromRequest != 0 {
+		return fromRequest, nil
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, nil
+	}
+	values := md.Get(ifMatchMetadataKey)
+	if len(values) == 0 || strings.TrimSpace(values[0]) == "*" {
+		return 0, nil
+	}
+	// слабый или чужой ETag по RFC 9110 не совпадает ни с чем: это несработавшее условие (412),
+	// а не ошибка запроса
+	version, ok := parseETag(values[0])
+	if !ok {
+		return 0, status.Error(codes.Aborted, "If-Match does not match the current tweet")
+	}
+	return version, nil
+}
+
+// ETagMetadata передает If-Match из HTTP-запроса в метаданные gRPC
+func ETagMetadata(ctx context.Context, r *http.Request) metadata.MD {
+	if ifMatch := r.Header.Get("If-Match"); ifMatch != "" {
+		return metadata.Pairs(ifMatchMetadataKey, ifMatch)
+	}
+	return nil
+}
+
+// ETagResponseOption проставляет заголовок ETag в ответах, содержащих один твит
+func ETagResponseOption(ctx context.Context, w http.ResponseWriter, m proto.Message) error {
+	resp, ok := m.(interface{ GetTweet() *pb.Tweet })
+	if !ok || resp.GetTweet() == nil || resp.GetTweet().Version == 0 {
+		return nil
+	}
+	etag, err := formatETag(resp.GetTweet().Version, m)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("ETag", etag)
+	// ответ зависит от читателя
+	w.Header().Add("Vary", "Authorization")
+	return nil
+}
+
+// ConditionalGetMiddleware отвечает 304 Not Modified без тела, если ETag успешного ответа
+// совпал с одним из значений If-None-Match
+func ConditionalGetMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifNoneMatch := r.Header.Get("If-None-Match")
+		if ifNoneMatch == "" || (r.Method != http.MethodGet && r.Method != http.MethodHead) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(&conditionalWriter{ResponseWriter: w, ifNoneMatch: ifNoneMatch}, r)
+	})
+}
+
+type conditionalWriter struct {
+	http.ResponseWriter
+	ifNoneMatch string
+	wroteHeader bool
+	notModified bool
+}
+
+func (w *conditionalWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	if code == http.StatusOK && etagMatches(w.ifNoneMatch, w.Header().Get("ETag")) {
+		w.notModified = true
+		w.Header().Del("Content-Type")
+		w.Header().Del("Content-Length")
+		w.ResponseWriter.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *conditionalWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.notModified {
+		return len(b), nil
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// etagMatches сравнивает ETag со списком из If-None-Match по слабому правилу: префикс W/ не учитывается
+func etagMatches(header string, etag string) bool {
+	if etag == "" {
+		return false
+	}
+	if strings.TrimSpace(header) == "*" {
+		return true
+	}
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(header, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
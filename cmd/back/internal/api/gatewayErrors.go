@@ -0,0 +1,68 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"twitter/internal/requestid"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrorHandler дополняет стандартный обработчик ошибок gateway:
+//   - переносит счетчики лимита в X-RateLimit-*, а RetryInfo в Retry-After;
+//   - отвечает 412 Precondition Failed, если версия из If-Match устарела;
+//   - добавляет в тело RequestInfo с идентификатором запроса, в том числе для ошибок самого gateway.
+func ErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter,
+	r *http.Request, err error) {
+	if md, ok := runtime.ServerMetadataFromContext(ctx); ok {
+		setRateLimitHeaders(w, md.HeaderMD)
+		setRateLimitHeaders(w, md.TrailerMD)
+	}
+
+	st := withRequestInfo(status.Convert(err), requestid.FromContext(r.Context()))
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			w.Header().Set("Retry-After", strconv.FormatInt(retryAfterSeconds(info.RetryDelay.AsDuration()), 10))
+		}
+	}
+
+	if st.Code() == codes.Aborted && r.Header.Get("If-Match") != "" {
+		w = &statusOverrideWriter{ResponseWriter: w, statusCode: http.StatusPreconditionFailed}
+	}
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, st.Err())
+}
+
+// RateLimitResponseOption переносит счетчики лимита метода в заголовки успешного ответа
+func RateLimitResponseOption(ctx context.Context, w http.ResponseWriter, _ proto.Message) error {
+	if md, ok := runtime.ServerMetadataFromContext(ctx); ok {
+		setRateLimitHeaders(w, md.HeaderMD)
+	}
+	return nil
+}
+
+// OutgoingHeaderMatcher отдает метаданные ответа gRPC как заголовки Grpc-Metadata-*.
+// Заголовки лимита пропускаются: их выставляют RateLimitResponseOption и ErrorHandler,
+// заменяя значения общего лимита из RateLimitMiddleware. X-Request-ID выставляет RequestIDMiddleware
+func OutgoingHeaderMatcher(key string) (string, bool) {
+	if isRateLimitHeader(key) || key == requestid.MetadataKey {
+		return "", false
+	}
+	if key == idempotencyReplayedKey {
+		return http.CanonicalHeaderKey(key), true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+type statusOverrideWriter struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (w *statusOverrideWriter) WriteHeader(int) {
+	w.ResponseWriter.WriteHeader(w.statusCode)
+}
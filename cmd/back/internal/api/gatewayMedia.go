@@ -0,0 +1,193 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	pb "twitter/api/proto/v1"
+	"twitter/cmd/back/internal/app"
+	"twitter/cmd/back/internal/blob"
+	"twitter/cmd/back/internal/media"
+	"twitter/internal/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// uploadChunkSize размер куска файла в одном сообщении UploadMedia
+const uploadChunkSize = 64 << 10
+
+// MediaUploadHandler принимает файл из поля "file" формы multipart/form-data
+// и пересылает его в UploadMedia
+func MediaUploadHandler(mux *runtime.ServeMux, client pb.TwitterAPIClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		ctx := r.Context()
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+
+		// запас на заголовки формы поверх размера файла
+		r.Body = http.MaxBytesReader(w, r.Body, media.MaxSize+1<<20)
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+		defer file.Close()
+
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", auth)
+		}
+		stream, err := client.UploadMedia(ctx)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		info := &pb.MediaInfo{MimeType: header.Header.Get("Content-Type")}
+		if err := stream.Send(&pb.UploadMediaRequest{Payload: &pb.UploadMediaRequest_Info{Info: info}}); err != nil {
+			_, err = stream.CloseAndRecv()
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		buf := make([]byte, uploadChunkSize)
+		for {
+			n, readErr := file.Read(buf)
+			if n > 0 {
+				chunk := &pb.UploadMediaRequest{Payload: &pb.UploadMediaRequest_Chunk{Chunk: buf[:n]}}
+				if err := stream.Send(chunk); err != nil {
+					// настоящая ошибка придет из CloseAndRecv
+					break
+				}
+			}
+			if readErr == io.EOF {
+				break
+			}
+			if readErr != nil {
+				runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, status.Error(codes.InvalidArgument, readErr.Error()))
+				return
+			}
+		}
+
+		resp, err := stream.CloseAndRecv()
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+			return
+		}
+		runtime.ForwardResponseMessage(ctx, mux, outboundMarshaler, w, r, resp)
+	}
+}
+
+// Кэширование отдаваемых файлов. Файлы не меняются после загрузки, но доступ к ним может
+// пропасть: твит удалят, автор закроет аккаунт или заблокирует читателя. Поэтому и общие кэши
+// держат ответ недолго и после этого перепроверяют доступ у сервера
+const (
+	publicMediaCacheControl  = "public, max-age=300, must-revalidate"
+	privateMediaCacheControl = "private, max-age=300"
+)
+
+// MediaDownloadHandler отдает файл из blob-хранилища по параметру пути id. При thumbnail=true отдается превью.
+// Файл виден тем же, кому виден твит, к которому он прикреплен; неприкрепленный - только загрузившему.
+// Общим кэшам разрешено хранить только ответы анонимным читателям: их видит кто угодно
+func (s GrpcServer) MediaDownloadHandler(mux *runtime.ServeMux, thumbnail bool) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		ctx := r.Context()
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+
+		id, err := uuid.FromString(pathParams["id"])
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, status.Error(codes.InvalidArgument, "invalid media id"))
+			return
+		}
+
+		ctx, err = s.httpViewer(r)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		m, err := s.visibleMedia(ctx, id)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		key := mediaKey(id)
+		if thumbnail {
+			key = thumbnailKey(id)
+		}
+		body, err := s.BlobStore.Get(ctx, key)
+		if errors.Is(err, blob.ErrNotFound) {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, status.Error(codes.NotFound, "media not found"))
+			return
+		}
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+			return
+		}
+		defer body.Close()
+
+		w.Header().Set("Content-Type", m.MimeType)
+		w.Header().Set("Vary", "Authorization")
+		if _, err := GetUserIDFromContext(ctx); err == nil {
+			w.Header().Set("Cache-Control", privateMediaCacheControl)
+		} else {
+			w.Header().Set("Cache-Control", publicMediaCacheControl)
+		}
+		if _, err := io.Copy(w, body); err != nil {
+			// заголовки уже отправлены, ответить ошибкой нельзя
+			logger.FromContext(ctx).WarnContext(ctx, "media download copy failed", "media_id", id.String(), "error", err)
+		}
+	}
+}
+
+// httpViewer кладет в контекст запроса пользователя из заголовка Authorization, как AuthInterceptor.
+// Без заголовка читатель анонимный, с недействительным токеном запрос отклоняется
+func (s GrpcServer) httpViewer(r *http.Request) (context.Context, error) {
+	ctx := r.Context()
+	auth := r.Header.Get("Authorization")
+	if auth == "" {
+		return ctx, nil
+	}
+	claims, err := ValidateToken(strings.TrimPrefix(auth, "Bearer "), s.Settings.JwtSecret())
+	if err != nil || claims.UserID == "" {
+		return ctx, status.Error(codes.Unauthenticated, "invalid token")
+	}
+	return context.WithValue(ctx, UserIDKey, claims.UserID), nil
+}
+
+// visibleMedia возвращает файл, если текущий пользователь может его видеть. Для недоступного
+// файла возвращается NotFound, как и для несуществующего
+func (s GrpcServer) visibleMedia(ctx context.Context, id uuid.UUID) (app.Media, error) {
+	m, err := s.Database.GetMediaByIDFromDB(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return app.Media{}, status.Error(codes.NotFound, "media not found")
+	}
+	if err != nil {
+		return app.Media{}, fmt.Errorf("GetMediaByIDFromDB: %w", err)
+	}
+
+	tweetId, err := s.Database.GetMediaTweetIDFromDB(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		if userId, err := GetUserIDFromContext(ctx); err == nil && userId == m.UserId.String() {
+			return m, nil
+		}
+		return app.Media{}, status.Error(codes.NotFound, "media not found")
+	}
+	if err != nil {
+		return app.Media{}, fmt.Errorf("GetMediaTweetIDFromDB: %w", err)
+	}
+	if _, err := s.visibleTweet(ctx, tweetId); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return app.Media{}, status.Error(codes.NotFound, "media not found")
+		}
+		return app.Media{}, err
+	}
+	return m, nil
+}
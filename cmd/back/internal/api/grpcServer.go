@@ -2,13 +2,22 @@ package api
 
 import (
 	"context"
-	"encoding/json"
+	"database/sql"
+	"errors"
 	"fmt"
+	"strconv"
 	"time"
 	pb "twitter/api/proto/v1"
 	"twitter/cmd/back/internal/app"
+	"twitter/cmd/back/internal/blob"
+	"twitter/cmd/back/internal/cache"
+	"twitter/internal/logger"
+	"twitter/internal/rabbitmq"
 
 	"github.com/gofrs/uuid/v5"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -23,21 +32,77 @@ type Mess struct {
 type Repository interface {
 	CreateTweetToDB(ctx context.Context, tweet app.Tweet) (app.Tweet, error)
 	GetTweetByIDFromDB(ctx context.Context, tweet app.Tweet) (app.Tweet, error)
-	GetUserTweetsFromDB(ctx context.Context, userId uuid.UUID) ([]app.Tweet, error)
+	GetUserTweetIDsFromDB(ctx context.Context, userId uuid.UUID, limit int) ([]app.Tweet, error)
 	UpdateTweetToDB(ctx context.Context, tweet app.Tweet) (app.Tweet, error)
 	DeleteTweetFromDB(ctx context.Context, tweet app.Tweet) error
-	GetSubscribersTweetsFromDB(ctx context.Context, userIds []uuid.UUID) ([]app.Tweet, error)
+	GetPollsFromDB(ctx context.Context, tweetIds []uuid.UUID) (map[uuid.UUID]app.Poll, error)
+	VotePollToDB(ctx context.Context, vote app.PollVote) error
+	CreateMediaToDB(ctx context.Context, media app.Media) (app.Media, error)
+	GetMediaByIDFromDB(ctx context.Context, id uuid.UUID) (app.Media, error)
+	GetMediaTweetIDFromDB(ctx context.Context, mediaId uuid.UUID) (uuid.UUID, error)
+	GetTweetMediaFromDB(ctx context.Context, tweetIds []uuid.UUID) (map[uuid.UUID][]app.Media, error)
+	GetTweetsByIDsFromDB(ctx context.Context, ids []uuid.UUID) ([]app.Tweet, error)
+	AddBookmarkToDB(ctx context.Context, bookmark app.Bookmark) error
+	DeleteBookmarkFromDB(ctx context.Context, bookmark app.Bookmark) error
+	GetBookmarksFromDB(ctx context.Context, userId uuid.UUID, folderId uuid.NullUUID, cursor *app.PageCursor, limit int) ([]app.Bookmark, error)
+	GetBookmarkedTweetIDsFromDB(ctx context.Context, userId uuid.UUID, tweetIds []uuid.UUID) (map[uuid.UUID]bool, error)
+	CreateBookmarkFolderToDB(ctx context.Context, folder app.BookmarkFolder) (app.BookmarkFolder, error)
+	GetBookmarkFoldersFromDB(ctx context.Context, userId uuid.UUID) ([]app.BookmarkFolder, error)
+	RenameBookmarkFolderToDB(ctx context.Context, folder app.BookmarkFolder) (app.BookmarkFolder, error)
+	DeleteBookmarkFolderFromDB(ctx context.Context, folder app.BookmarkFolder) error
+	GetTweetsByUsersFromDB(ctx context.Context, userIds []uuid.UUID, cursor *app.PageCursor, limit int) ([]app.Tweet, error)
+	CreateListToDB(ctx context.Context, list app.List) (app.List, error)
+	GetListByIDFromDB(ctx context.Context, id uuid.UUID) (app.List, error)
+	GetUserListsFromDB(ctx context.Context, ownerId uuid.UUID, includePrivate bool) ([]app.List, error)
+	UpdateListToDB(ctx context.Context, list app.List) (app.List, error)
+	DeleteListFromDB(ctx context.Context, list app.List) error
+	AddListMemberToDB(ctx context.Context, list app.List, userId uuid.UUID) error
+	DeleteListMemberFromDB(ctx context.Context, list app.List, userId uuid.UUID) error
+	GetListMembersFromDB(ctx context.Context, listId uuid.UUID) ([]uuid.UUID, error)
+	BlockUserToDB(ctx context.Context, userId uuid.UUID, targetId uuid.UUID) error
+	UnblockUserFromDB(ctx context.Context, userId uuid.UUID, targetId uuid.UUID) error
+	MuteUserToDB(ctx context.Context, userId uuid.UUID, targetId uuid.UUID) error
+	UnmuteUserFromDB(ctx context.Context, userId uuid.UUID, targetId uuid.UUID) error
+	GetHiddenAuthorsFromDB(ctx context.Context, viewerId uuid.UUID, authorIds []uuid.UUID) (map[uuid.UUID]bool, error)
+	SetProtectedToDB(ctx context.Context, userId uuid.UUID, protected bool) error
+	IsProtectedFromDB(ctx context.Context, userId uuid.UUID) (bool, error)
+	RequestAccessToDB(ctx context.Context, request app.AccessRequest) (app.AccessRequest, error)
+	GetAccessRequestsFromDB(ctx context.Context, ownerId uuid.UUID) ([]app.AccessRequest, error)
+	ApproveAccessRequestToDB(ctx context.Context, ownerId uuid.UUID, readerId uuid.UUID) error
+	RevokeAccessFromDB(ctx context.Context, ownerId uuid.UUID, readerId uuid.UUID) error
+	GetProfileFromDB(ctx context.Context, userId uuid.UUID) (app.Profile, error)
+	GetProfileByUsernameFromDB(ctx context.Context, username string) (app.Profile, error)
+	GetProfilesByIDsFromDB(ctx context.Context, userIds []uuid.UUID) (map[uuid.UUID]app.Profile, error)
+	UpsertProfileToDB(ctx context.Context, profile app.Profile) (app.Profile, error)
 }
 
 type CacheTweets interface {
 	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error
 	Get(ctx context.Context, key string) (string, error)
-	GetDelete(ctx context.Context, key string) (string, error)
+	Delete(ctx context.Context, keys ...string) error
+	GetMany(ctx context.Context, keys ...string) (map[string]string, error)
+	SetMany(ctx context.Context, values map[string]interface{}, expiration time.Duration) error
+	// SetVersioned и SetManyVersioned не заменяют запись с такой же или более новой версией твита
+	SetVersioned(ctx context.Context, key string, value []byte, expiration time.Duration) error
+	SetManyVersioned(ctx context.Context, values map[string][]byte, expiration time.Duration) error
+}
+
+// TweetCodec кодирует значения CacheDBTweets. Записи, которые не удалось декодировать,
+// считаются промахом. Значения сравниваются по версии в заголовке формата cache.TweetCodec
+type TweetCodec interface {
+	Encode(tweet app.Tweet) ([]byte, error)
+	Decode(data []byte) (app.Tweet, error)
 }
 type CacheUserTweet interface {
-	AddToRight(ctx context.Context, key string, items ...string) error
-	GetList(ctx context.Context, key string) ([]string, error)
-	RemoveElements(ctx context.Context, key string, value string) (int64, error)
+	ReplaceSorted(ctx context.Context, key string, members []cache.SortedMember, expiration time.Duration) error
+	AddSortedIfExists(ctx context.Context, key string, member cache.SortedMember, maxLen int64) error
+	RangeSortedDesc(ctx context.Context, key string, limit int64) ([]string, error)
+	RemoveSorted(ctx context.Context, key string, members ...string) error
+}
+type CachePolls interface {
+	IncrementField(ctx context.Context, key string, field string, incr int64) error
+	GetFields(ctx context.Context, key string) (map[string]string, error)
+	SetFields(ctx context.Context, key string, values map[string]interface{}, expiration time.Duration) error
 }
 type Producer interface {
 	PublishJSON(ctx context.Context, routingKey string, message interface{}) error
@@ -45,10 +110,18 @@ type Producer interface {
 
 type GrpcServer struct {
 	Database          Repository
-	JwtSecret         string
+	Settings          *Settings
 	CacheDBTweets     CacheTweets
 	CacheDBUserTweets CacheUserTweet
+	CacheDBPolls      CachePolls
 	Producer          Producer
+	BlobStore         blob.Store
+	// TweetLoads объединяет одновременные чтения одного твита из базы при промахе кэша
+	TweetLoads *singleflight.Group
+	// TweetCodec по умолчанию cache.TweetCodec без сжатия
+	TweetCodec TweetCodec
+	// CacheFallback что делать с чтениями, пока breaker Redis разомкнут. По умолчанию skip
+	CacheFallback string
 }
 
 // const authScheme = "Bearer"
@@ -60,118 +133,104 @@ func (s GrpcServer) CreateTweet(ctx context.Context, request *pb.CreateTweetRequ
 		return nil, err
 	}
 
+	if err := request.GetPoll().Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	for _, m := range request.Media {
+		if err := m.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if len(request.Media) > maxTweetMedia {
+		return nil, status.Errorf(codes.InvalidArgument, "too many media attachments, max %d", maxTweetMedia)
+	}
+
 	newTweet := app.Tweet{
 		Text:   request.Text,
 		UserId: uuid.FromStringOrNil(userId),
+		Poll:   fromCreatePoll(request.GetPoll()),
+		Media:  fromMediaAttachments(request.Media),
 	}
 	tweet, err := s.Database.CreateTweetToDB(ctx, newTweet)
+	if errors.Is(err, app.ErrMediaNotFound) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 
 		return nil, fmt.Errorf("CreateTweetToDB: %w", err)
 	}
 
-	tweetJSON, err := json.Marshal(tweet)
-	if err != nil {
-		fmt.Println("Ошибка сериализации:", err)
-	}
-
-	err = s.CacheDBTweets.Set(ctx, tweet.Id.String(), tweetJSON, 10*time.Minute)
-	if err != nil {
-		fmt.Println("Ошибка SET:", err)
-	} else {
-		fmt.Println("SET операция выполнена успешно")
-	}
+	s.cacheTweet(ctx, tweet)
 
 	// используется для GetUserTweets
-	err = s.CacheDBUserTweets.AddToRight(ctx, tweet.UserId.String(), string(tweetJSON))
-	if err != nil {
-		fmt.Println("Ошибка AddToRight:", err)
-	} else {
-		fmt.Println("AddToRight операция выполнена успешно (CreateTweet)")
-	}
+	s.addToUserTimeline(ctx, tweet)
 
 	// отправить в очередь
 	message := Mess{Message: "Create Tweet"}
 	err = s.Producer.PublishJSON(ctx, MessageQueue, message)
 	if err != nil {
-		fmt.Println("Rabbit error Create:", err)
+		logger.FromContext(ctx).ErrorContext(ctx, "publish failed", "routing_key", MessageQueue, "error", err)
 	}
 
+	pbTweet := toTweet(tweet)
+	s.attachAuthors(ctx, []*pb.Tweet{pbTweet})
+
 	return &pb.CreateTweetResponse{
-		Tweet: &pb.Tweet{
-			Id:        tweet.Id.String(),
-			Text:      tweet.Text,
-			CreatedAt: timestamppb.New(tweet.CreatedAt),
-			UpdatedAt: timestamppb.New(tweet.UpdatedAt),
-			UserId:    tweet.UserId.String(),
-		},
+		Tweet: pbTweet,
 	}, nil
 }
 
 func (s GrpcServer) GetTweetByID(ctx context.Context, request *pb.GetTweetByIDRequest) (*pb.GetTweetByIDResponse, error) {
 
-	tweet := app.Tweet{
-		Id: uuid.FromStringOrNil(request.Id),
+	if err := request.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	tweet, err := s.getTweet(ctx, uuid.FromStringOrNil(request.Id))
+	if errors.Is(err, app.ErrTweetNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	tweetRedis, err := s.CacheDBTweets.Get(ctx, request.Id)
+	pbTweets, err := s.presentTweets(ctx, []app.Tweet{tweet})
 	if err != nil {
-		fmt.Println("Нет в редис", err)
-		tweet, err = s.Database.GetTweetByIDFromDB(ctx, tweet)
-		if err != nil {
-			return nil, fmt.Errorf("GetTweetByIDFromDB: %w", err)
-		}
-	} else {
-		err := json.Unmarshal([]byte(tweetRedis), &tweet)
-		if err != nil {
-			fmt.Println("Ошибка десериализации GetTweetByID:", err)
-		}
+		return nil, err
+	}
+	if len(pbTweets) == 0 {
+		return nil, status.Error(codes.NotFound, app.ErrTweetNotFound.Error())
 	}
 
-	return &pb.GetTweetByIDResponse{Tweet: &pb.Tweet{
-		Id:        tweet.Id.String(),
-		Text:      tweet.Text,
-		CreatedAt: timestamppb.New(tweet.CreatedAt),
-		UpdatedAt: timestamppb.New(tweet.UpdatedAt),
-		UserId:    tweet.UserId.String(),
-	},
-	}, nil
+	return &pb.GetTweetByIDResponse{Tweet: pbTweets[0]}, nil
 }
 
 func (s GrpcServer) GetUserTweets(ctx context.Context, request *pb.GetUserTweetsRequest) (*pb.GetUserTweetsResponse, error) {
 
-	userId, err := GetUserIDFromContext(ctx)
+	if err := request.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cursor, err := decodePageToken(request.PageToken)
 	if err != nil {
-		return nil, err
+		return nil, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+	pageSize := int(request.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultTimelinePageSize
 	}
 
-	var pbTweets []*pb.Tweet
-
-	tweetsRedis, err := s.CacheDBUserTweets.GetList(ctx, userId)
+	tweets, nextPageToken, err := s.getUserTimeline(ctx, uuid.FromStringOrNil(request.UserId), cursor, pageSize)
 	if err != nil {
-		fmt.Println("Нет в редис", err)
-		tweets, err := s.Database.GetUserTweetsFromDB(ctx, uuid.FromStringOrNil(userId))
-		if err != nil {
-			return nil, fmt.Errorf("GetUserTweetsFromDB: %w", err)
-		}
-		pbTweets = make([]*pb.Tweet, len(tweets))
-		for i := range tweets {
-			pbTweets[i] = toTweet(tweets[i])
-		}
-	} else {
-		pbTweets = make([]*pb.Tweet, len(tweetsRedis))
-		for i, t := range tweetsRedis {
-			var tweet app.Tweet
-			err := json.Unmarshal([]byte(t), &tweet)
-			pbTweets[i] = toTweet(tweet)
-			if err != nil {
-				fmt.Println("Ошибка десериализации GetUserTweets:", err)
-			}
-		}
+		return nil, err
+	}
 
+	pbTweets, err := s.presentTweets(ctx, tweets)
+	if err != nil {
+		return nil, err
 	}
 	return &pb.GetUserTweetsResponse{
-		Tweets: pbTweets,
+		Tweets:        pbTweets,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
@@ -182,44 +241,51 @@ func (s GrpcServer) UpdateTweet(ctx context.Context, request *pb.UpdateTweetRequ
 		return nil, err
 	}
 
+	if err := request.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	version, err := expectedVersion(ctx, request.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
 	newTweet := app.Tweet{
-		Id:     uuid.FromStringOrNil(request.Id),
-		Text:   request.Text,
-		UserId: uuid.FromStringOrNil(userId),
+		Id:      uuid.FromStringOrNil(request.Id),
+		Text:    request.Text,
+		UserId:  uuid.FromStringOrNil(userId),
+		Version: version,
 	}
 	tweet, err := s.Database.UpdateTweetToDB(ctx, newTweet)
+	if errors.Is(err, app.ErrTweetNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, app.ErrVersionConflict) {
+		return nil, status.Error(codes.Aborted, err.Error())
+	}
 	if err != nil {
 
 		return nil, fmt.Errorf("UpdateTweetToDB: %w", err)
 	}
-
-	tweetJSON, err := json.Marshal(tweet)
-	if err != nil {
-		fmt.Println("Ошибка сериализации:", err)
+	tweets := []app.Tweet{tweet}
+	if err := s.hydrateTweets(ctx, tweets); err != nil {
+		return nil, fmt.Errorf("hydrateTweets: %w", err)
 	}
+	tweet = tweets[0]
 
-	err = s.CacheDBTweets.Set(ctx, tweet.Id.String(), tweetJSON, 10*time.Minute)
-	if err != nil {
-		fmt.Println("Ошибка SET:", err)
-	} else {
-		fmt.Println("SET операция выполнена успешно")
-	}
+	s.cacheTweet(ctx, tweet)
 
 	// отправить в очередь
 	message := Mess{Message: "Update Tweet"}
 	err = s.Producer.PublishJSON(ctx, MessageQueue, message)
 	if err != nil {
-		fmt.Println("Rabbit error Update:", err)
+		logger.FromContext(ctx).ErrorContext(ctx, "publish failed", "routing_key", MessageQueue, "error", err)
 	}
 
-	return &pb.UpdateTweetResponse{Tweet: &pb.Tweet{
-		Id:        tweet.Id.String(),
-		Text:      tweet.Text,
-		CreatedAt: timestamppb.New(tweet.CreatedAt),
-		UpdatedAt: timestamppb.New(tweet.UpdatedAt),
-		UserId:    tweet.UserId.String(),
-	},
-	}, nil
+	pbTweet := toTweet(tweet)
+	s.markBookmarked(ctx, []*pb.Tweet{pbTweet})
+	s.attachAuthors(ctx, []*pb.Tweet{pbTweet})
+
+	return &pb.UpdateTweetResponse{Tweet: pbTweet}, nil
 }
 
 func (s GrpcServer) DeleteTweet(ctx context.Context, request *pb.DeleteTweetRequest) (*pb.DeleteTweetResponse, error) {
@@ -235,48 +301,130 @@ func (s GrpcServer) DeleteTweet(ctx context.Context, request *pb.DeleteTweetRequ
 	}
 
 	err = s.Database.DeleteTweetFromDB(ctx, tweet)
-	if err != nil {
-		return nil, fmt.Errorf("DeleteTweet: %w", err)
+	if errors.Is(err, app.ErrTweetNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
 	}
-
-	tweetJSON, err := s.CacheDBTweets.GetDelete(ctx, tweet.Id.String())
 	if err != nil {
-		fmt.Println("Ошибка CacheDBTweets.GetDelete:", err)
-	} else {
-		fmt.Println("CacheDBTweets.GetDelete операция выполнена успешно")
+		return nil, fmt.Errorf("DeleteTweet: %w", err)
 	}
 
-	_, err = s.CacheDBUserTweets.RemoveElements(ctx, tweet.UserId.String(), tweetJSON)
+	// отметка вместо удаления: чтение из базы, начатое до удаления, не вернет твит в кэш
+	err = s.CacheDBTweets.Set(ctx, tweetKey(tweet.Id), tweetNotFoundMarker, s.Settings.TweetNotFoundTTL())
 	if err != nil {
-		fmt.Println("Ошибка RemoveElements:", err)
+		logger.FromContext(ctx).WarnContext(ctx, "cache set failed", "cache", "tweets", "key", tweetKey(tweet.Id), "error", err)
 	}
+	s.removeFromUserTimeline(ctx, tweet)
 
 	// отправить в очередь
 	message := Mess{Message: "delete"} //id tweet отправить
 	err = s.Producer.PublishJSON(ctx, MessageQueue, message)
 	if err != nil {
-		fmt.Println("Rabbit error Delete:", err)
+		logger.FromContext(ctx).ErrorContext(ctx, "publish failed", "routing_key", MessageQueue, "error", err)
+	}
+
+	// закладки и другие зависимые данные чистятся подписчиками этого события
+	event := app.TweetDeletedEvent{TweetId: tweet.Id, UserId: tweet.UserId}
+	err = s.Producer.PublishJSON(ctx, rabbitmq.TweetDeletedQueue, event)
+	if err != nil {
+		logger.FromContext(ctx).ErrorContext(ctx, "publish failed", "routing_key", rabbitmq.TweetDeletedQueue, "error", err)
 	}
 
 	return &pb.DeleteTweetResponse{}, nil
 }
 
 func (s GrpcServer) GetSubscribersTweets(ctx context.Context, request *pb.GetSubscribersTweetsRequest) (*pb.GetSubscribersTweetsResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cursor, err := decodePageToken(request.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+	pageSize := int(request.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultTimelinePageSize
+	}
+
 	listSubscribers := request.UserIds
 	listSubscribersUuids := make([]uuid.UUID, len(listSubscribers))
 	for i := range listSubscribers {
 		listSubscribersUuids[i] = uuid.FromStringOrNil(listSubscribers[i])
 	}
-	tweets, err := s.Database.GetSubscribersTweetsFromDB(ctx, listSubscribersUuids)
+	tweets, err := s.Database.GetTweetsByUsersFromDB(ctx, listSubscribersUuids, cursor, pageSize+1)
 	if err != nil {
-		return nil, fmt.Errorf("GetSubscribersTweetsFromDB: %w", err)
+		return nil, fmt.Errorf("GetTweetsByUsersFromDB: %w", err)
 	}
-	pbTweets := make([]*pb.Tweet, len(tweets))
-	for i := range tweets {
-		pbTweets[i] = toTweet(tweets[i])
+	var nextPageToken string
+	if len(tweets) > pageSize {
+		tweets = tweets[:pageSize]
+		last := tweets[len(tweets)-1]
+		nextPageToken = encodePageToken(app.PageCursor{CreatedAt: last.CreatedAt, TweetId: last.Id})
+	}
+	if err := s.hydrateTweets(ctx, tweets); err != nil {
+		return nil, fmt.Errorf("hydrateTweets: %w", err)
+	}
+	pbTweets, err := s.presentTweets(ctx, tweets)
+	if err != nil {
+		return nil, err
 	}
 
-	return &pb.GetSubscribersTweetsResponse{Tweets: pbTweets}, nil
+	return &pb.GetSubscribersTweetsResponse{Tweets: pbTweets, NextPageToken: nextPageToken}, nil
+}
+
+func (s GrpcServer) VotePoll(ctx context.Context, request *pb.VotePollRequest) (*pb.VotePollResponse, error) {
+
+	userId, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// голосовать можно только в опросах, которые пользователь видит
+	if _, err := s.visibleTweet(ctx, uuid.FromStringOrNil(request.TweetId)); err != nil {
+		return nil, err
+	}
+
+	vote := app.PollVote{
+		TweetId:  uuid.FromStringOrNil(request.TweetId),
+		UserId:   uuid.FromStringOrNil(userId),
+		Position: request.Position,
+	}
+	err = s.Database.VotePollToDB(ctx, vote)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, status.Error(codes.NotFound, "poll not found")
+	case errors.Is(err, app.ErrPollOptionNotFound):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, app.ErrPollClosed):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, app.ErrAlreadyVoted):
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	case err != nil:
+		return nil, fmt.Errorf("VotePollToDB: %w", err)
+	}
+
+	err = s.CacheDBPolls.IncrementField(ctx, pollKey(vote.TweetId), strconv.Itoa(int(vote.Position)), 1)
+	if err != nil {
+		logger.FromContext(ctx).WarnContext(ctx, "cache update failed", "cache", "polls", "key", pollKey(vote.TweetId), "error", err)
+	}
+
+	polls, err := s.Database.GetPollsFromDB(ctx, []uuid.UUID{vote.TweetId})
+	if err != nil {
+		return nil, fmt.Errorf("GetPollsFromDB: %w", err)
+	}
+	poll := polls[vote.TweetId]
+
+	// отправить в очередь
+	message := Mess{Message: "Vote Poll"}
+	err = s.Producer.PublishJSON(ctx, MessageQueue, message)
+	if err != nil {
+		logger.FromContext(ctx).ErrorContext(ctx, "publish failed", "routing_key", MessageQueue, "error", err)
+	}
+
+	return &pb.VotePollResponse{Poll: toPoll(&poll)}, nil
 }
 
 func toTweet(t app.Tweet) *pb.Tweet {
@@ -286,5 +434,16 @@ func toTweet(t app.Tweet) *pb.Tweet {
 		CreatedAt: timestamppb.New(t.CreatedAt),
 		UpdatedAt: timestamppb.New(t.UpdatedAt),
 		UserId:    t.UserId.String(),
+		Version:   t.Version,
+		Poll:      toPoll(t.Poll),
+		Media:     toMediaList(t.Media),
+	}
+}
+
+// hydrateTweets подгружает опросы и вложения для твитов, полученных из базы
+func (s GrpcServer) hydrateTweets(ctx context.Context, tweets []app.Tweet) error {
+	if err := s.attachPolls(ctx, tweets); err != nil {
+		return err
 	}
+	return s.attachMedia(ctx, tweets)
 }
@@ -0,0 +1,273 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	pb "twitter/api/proto/v1"
+	"twitter/cmd/back/internal/app"
+
+	"github.com/gofrs/uuid/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const defaultTimelinePageSize = 20
+
+func (s GrpcServer) CreateList(ctx context.Context, request *pb.CreateListRequest) (*pb.CreateListResponse, error) {
+
+	userId, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	list, err := s.Database.CreateListToDB(ctx, app.List{
+		OwnerId:     uuid.FromStringOrNil(userId),
+		Name:        request.Name,
+		Description: request.Description,
+		Private:     request.Private,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("CreateListToDB: %w", err)
+	}
+
+	return &pb.CreateListResponse{List: toList(list)}, nil
+}
+
+func (s GrpcServer) GetList(ctx context.Context, request *pb.GetListRequest) (*pb.GetListResponse, error) {
+
+	list, err := s.visibleList(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetListResponse{List: toList(list)}, nil
+}
+
+func (s GrpcServer) UpdateList(ctx context.Context, request *pb.UpdateListRequest) (*pb.UpdateListResponse, error) {
+
+	userId, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	list, err := s.Database.UpdateListToDB(ctx, app.List{
+		Id:          uuid.FromStringOrNil(request.Id),
+		OwnerId:     uuid.FromStringOrNil(userId),
+		Name:        request.Name,
+		Description: request.Description,
+		Private:     request.Private,
+	})
+	if errors.Is(err, app.ErrListNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("UpdateListToDB: %w", err)
+	}
+
+	return &pb.UpdateListResponse{List: toList(list)}, nil
+}
+
+func (s GrpcServer) DeleteList(ctx context.Context, request *pb.DeleteListRequest) (*pb.DeleteListResponse, error) {
+
+	userId, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Database.DeleteListFromDB(ctx, app.List{
+		Id:      uuid.FromStringOrNil(request.Id),
+		OwnerId: uuid.FromStringOrNil(userId),
+	})
+	if errors.Is(err, app.ErrListNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("DeleteListFromDB: %w", err)
+	}
+
+	return &pb.DeleteListResponse{}, nil
+}
+
+func (s GrpcServer) GetUserLists(ctx context.Context, request *pb.GetUserListsRequest) (*pb.GetUserListsResponse, error) {
+
+	userId, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	lists, err := s.Database.GetUserListsFromDB(ctx, uuid.FromStringOrNil(request.UserId), request.UserId == userId)
+	if err != nil {
+		return nil, fmt.Errorf("GetUserListsFromDB: %w", err)
+	}
+	pbLists := make([]*pb.List, len(lists))
+	for i := range lists {
+		pbLists[i] = toList(lists[i])
+	}
+
+	return &pb.GetUserListsResponse{Lists: pbLists}, nil
+}
+
+func (s GrpcServer) AddListMember(ctx context.Context, request *pb.AddListMemberRequest) (*pb.AddListMemberResponse, error) {
+
+	userId, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	list := app.List{
+		Id:      uuid.FromStringOrNil(request.ListId),
+		OwnerId: uuid.FromStringOrNil(userId),
+	}
+	err = s.Database.AddListMemberToDB(ctx, list, uuid.FromStringOrNil(request.UserId))
+	switch {
+	case errors.Is(err, app.ErrListNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, app.ErrListFull):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return nil, fmt.Errorf("AddListMemberToDB: %w", err)
+	}
+
+	return &pb.AddListMemberResponse{}, nil
+}
+
+func (s GrpcServer) RemoveListMember(ctx context.Context, request *pb.RemoveListMemberRequest) (*pb.RemoveListMemberResponse, error) {
+
+	userId, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := app.List{
+		Id:      uuid.FromStringOrNil(request.ListId),
+		OwnerId: uuid.FromStringOrNil(userId),
+	}
+	if err := s.Database.DeleteListMemberFromDB(ctx, list, uuid.FromStringOrNil(request.UserId)); err != nil {
+		return nil, fmt.Errorf("DeleteListMemberFromDB: %w", err)
+	}
+
+	return &pb.RemoveListMemberResponse{}, nil
+}
+
+func (s GrpcServer) GetListMembers(ctx context.Context, request *pb.GetListMembersRequest) (*pb.GetListMembersResponse, error) {
+
+	list, err := s.visibleList(ctx, request.ListId)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.Database.GetListMembersFromDB(ctx, list.Id)
+	if err != nil {
+		return nil, fmt.Errorf("GetListMembersFromDB: %w", err)
+	}
+	userIds := make([]string, len(members))
+	for i := range members {
+		userIds[i] = members[i].String()
+	}
+
+	return &pb.GetListMembersResponse{UserIds: userIds}, nil
+}
+
+// GetListTimeline лента твитов участников списка, тот же запрос по user_id = ANY, что и в GetSubscribersTweets,
+// но с постраничной выдачей
+func (s GrpcServer) GetListTimeline(ctx context.Context, request *pb.GetListTimelineRequest) (*pb.GetListTimelineResponse, error) {
+
+	if err := request.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	list, err := s.visibleList(ctx, request.ListId)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := decodePageToken(request.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+	pageSize := int(request.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultTimelinePageSize
+	}
+
+	members, err := s.Database.GetListMembersFromDB(ctx, list.Id)
+	if err != nil {
+		return nil, fmt.Errorf("GetListMembersFromDB: %w", err)
+	}
+	if len(members) == 0 {
+		return &pb.GetListTimelineResponse{}, nil
+	}
+
+	tweets, err := s.Database.GetTweetsByUsersFromDB(ctx, members, cursor, pageSize+1)
+	if err != nil {
+		return nil, fmt.Errorf("GetTweetsByUsersFromDB: %w", err)
+	}
+	var nextPageToken string
+	if len(tweets) > pageSize {
+		tweets = tweets[:pageSize]
+		last := tweets[len(tweets)-1]
+		nextPageToken = encodePageToken(app.PageCursor{CreatedAt: last.CreatedAt, TweetId: last.Id})
+	}
+	if err := s.hydrateTweets(ctx, tweets); err != nil {
+		return nil, fmt.Errorf("hydrateTweets: %w", err)
+	}
+
+	pbTweets, err := s.presentTweets(ctx, tweets)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetListTimelineResponse{Tweets: pbTweets, NextPageToken: nextPageToken}, nil
+}
+
+// visibleList возвращает список, если текущий пользователь может его видеть.
+// Для чужого приватного списка возвращается NotFound, чтобы не раскрывать его существование
+func (s GrpcServer) visibleList(ctx context.Context, id string) (app.List, error) {
+	userId, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return app.List{}, err
+	}
+
+	list, err := s.Database.GetListByIDFromDB(ctx, uuid.FromStringOrNil(id))
+	if errors.Is(err, app.ErrListNotFound) {
+		return app.List{}, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return app.List{}, fmt.Errorf("GetListByIDFromDB: %w", err)
+	}
+	if !list.VisibleTo(uuid.FromStringOrNil(userId)) {
+		return app.List{}, status.Error(codes.NotFound, app.ErrListNotFound.Error())
+	}
+	return list, nil
+}
+
+func toList(l app.List) *pb.List {
+	return &pb.List{
+		Id:          l.Id.String(),
+		OwnerId:     l.OwnerId.String(),
+		Name:        l.Name,
+		Description: l.Description,
+		Private:     l.Private,
+		MemberCount: l.MemberCount,
+		CreatedAt:   timestamppb.New(l.CreatedAt),
+		UpdatedAt:   timestamppb.New(l.UpdatedAt),
+	}
+}
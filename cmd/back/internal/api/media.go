@@ -0,0 +1,165 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	pb "twitter/api/proto/v1"
+	"twitter/cmd/back/internal/app"
+	"twitter/cmd/back/internal/media"
+	"twitter/internal/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxTweetMedia = 4
+
+func mediaKey(id uuid.UUID) string {
+	return "media/" + id.String()
+}
+
+func thumbnailKey(id uuid.UUID) string {
+	return "media/" + id.String() + "_thumb"
+}
+
+// UploadMedia принимает изображение потоком: первым сообщением MediaInfo, затем куски файла
+func (s GrpcServer) UploadMedia(stream grpc.ClientStreamingServer[pb.UploadMediaRequest, pb.UploadMediaResponse]) error {
+	ctx := stream.Context()
+
+	userId, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	info := first.GetInfo()
+	if info == nil {
+		return status.Error(codes.InvalidArgument, "first message must contain media info")
+	}
+	if err := info.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var data bytes.Buffer
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if data.Len()+len(req.GetChunk()) > media.MaxSize {
+			return status.Error(codes.InvalidArgument, media.ErrTooLarge.Error())
+		}
+		data.Write(req.GetChunk())
+	}
+
+	img, err := media.Process(data.Bytes(), info.MimeType)
+	if errors.Is(err, media.ErrUnsupportedType) || errors.Is(err, media.ErrTooLarge) || errors.Is(err, media.ErrInvalidImage) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return fmt.Errorf("media.Process: %w", err)
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	if err := s.BlobStore.Put(ctx, mediaKey(id), bytes.NewReader(img.Data), img.MimeType); err != nil {
+		return fmt.Errorf("BlobStore.Put: %w", err)
+	}
+	if err := s.BlobStore.Put(ctx, thumbnailKey(id), bytes.NewReader(img.Thumbnail), img.MimeType); err != nil {
+		s.deleteMediaBlobs(ctx, id)
+		return fmt.Errorf("BlobStore.Put: %w", err)
+	}
+
+	m, err := s.Database.CreateMediaToDB(ctx, app.Media{
+		Id:       id,
+		UserId:   uuid.FromStringOrNil(userId),
+		MimeType: img.MimeType,
+		Size:     int64(len(img.Data)),
+		Width:    int32(img.Width),
+		Height:   int32(img.Height),
+	})
+	if err != nil {
+		s.deleteMediaBlobs(ctx, id)
+		return fmt.Errorf("CreateMediaToDB: %w", err)
+	}
+
+	return stream.SendAndClose(&pb.UploadMediaResponse{Media: toMedia(m)})
+}
+
+func (s GrpcServer) deleteMediaBlobs(ctx context.Context, id uuid.UUID) {
+	for _, key := range []string{mediaKey(id), thumbnailKey(id)} {
+		if err := s.BlobStore.Delete(ctx, key); err != nil {
+			logger.FromContext(ctx).WarnContext(ctx, "media blob delete failed", "key", key, "error", err)
+		}
+	}
+}
+
+// attachMedia подгружает вложения для твитов, полученных из базы
+func (s GrpcServer) attachMedia(ctx context.Context, tweets []app.Tweet) error {
+	if len(tweets) == 0 {
+		return nil
+	}
+	ids := make([]uuid.UUID, len(tweets))
+	for i := range tweets {
+		ids[i] = tweets[i].Id
+	}
+	media, err := s.Database.GetTweetMediaFromDB(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for i := range tweets {
+		tweets[i].Media = media[tweets[i].Id]
+	}
+	return nil
+}
+
+func fromMediaAttachments(attachments []*pb.MediaAttachment) []app.Media {
+	if len(attachments) == 0 {
+		return nil
+	}
+	media := make([]app.Media, len(attachments))
+	for i, a := range attachments {
+		media[i] = app.Media{
+			Id:      uuid.FromStringOrNil(a.MediaId),
+			AltText: a.AltText,
+		}
+	}
+	return media
+}
+
+func toMedia(m app.Media) *pb.Media {
+	return &pb.Media{
+		Id:           m.Id.String(),
+		MimeType:     m.MimeType,
+		Size:         m.Size,
+		Width:        m.Width,
+		Height:       m.Height,
+		Url:          "/media/" + m.Id.String(),
+		ThumbnailUrl: "/media/" + m.Id.String() + "/thumbnail",
+		AltText:      m.AltText,
+	}
+}
+
+func toMediaList(media []app.Media) []*pb.Media {
+	if len(media) == 0 {
+		return nil
+	}
+	pbMedia := make([]*pb.Media, len(media))
+	for i := range media {
+		pbMedia[i] = toMedia(media[i])
+	}
+	return pbMedia
+}
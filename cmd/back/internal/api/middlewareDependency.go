@@ -0,0 +1,44 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"twitter/internal/breaker"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// dependencyError переводит отказ зависимости в Unavailable, чтобы клиент повторил запрос,
+// а не получил Unknown. Истекший дедлайн самого запроса остается как есть
+func dependencyError(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, breaker.ErrOpen):
+		return status.Error(codes.Unavailable, "dependency unavailable")
+	case errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil:
+		return status.Error(codes.Unavailable, "dependency timed out")
+	}
+	return err
+}
+
+// DependencyErrorInterceptor ставится после перехватчика метрик, чтобы они видели итоговый код
+func DependencyErrorInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		return resp, dependencyError(ctx, err)
+	}
+}
+
+// DependencyErrorStreamInterceptor то же для потоковых методов
+func DependencyErrorStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return dependencyError(ss.Context(), handler(srv, ss))
+	}
+}
@@ -0,0 +1,86 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// gatewayMetadataKey метаданные, которыми gateway помечает свои вызовы gRPC
+const gatewayMetadataKey = "x-gateway-token"
+
+type gatewayKey struct{}
+
+// GatewayToken случайный секрет процесса. Gateway передает его в каждом вызове, и сервер по нему
+// отличает gateway от остальных клиентов, в том числе пришедших с того же адреса
+type GatewayToken string
+
+func NewGatewayToken() (GatewayToken, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("generate gateway token: %w", err)
+	}
+	return GatewayToken(hex.EncodeToString(raw)), nil
+}
+
+// GatewayClientInterceptor помечает вызовы клиента gateway токеном. Значение, пришедшее
+// от HTTP-клиента в Grpc-Metadata-X-Gateway-Token, заменяется
+func GatewayClientInterceptor(token GatewayToken) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(outgoingGatewayToken(ctx, token), method, req, reply, cc, opts...)
+	}
+}
+
+// GatewayStreamClientInterceptor то же для потоковых вызовов
+func GatewayStreamClientInterceptor(token GatewayToken) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+		streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(outgoingGatewayToken(ctx, token), desc, cc, method, opts...)
+	}
+}
+
+func outgoingGatewayToken(ctx context.Context, token GatewayToken) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	md.Set(gatewayMetadataKey, string(token))
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// GatewayInterceptor проверяет токен gateway и отмечает такие вызовы в контексте для fromGateway.
+// Должен стоять первым, чтобы отметку видели остальные перехватчики
+func GatewayInterceptor(token GatewayToken) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(markGateway(ctx, token), req)
+	}
+}
+
+// GatewayStreamInterceptor то же для потоковых методов
+func GatewayStreamInterceptor(token GatewayToken) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &wrappedStream{ServerStream: ss, ctx: markGateway(ss.Context(), token)})
+	}
+}
+
+func markGateway(ctx context.Context, token GatewayToken) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	values := md.Get(gatewayMetadataKey)
+	if len(values) != 1 || subtle.ConstantTimeCompare([]byte(values[0]), []byte(token)) != 1 {
+		return ctx
+	}
+	return context.WithValue(ctx, gatewayKey{}, true)
+}
+
+// fromGateway вызов пришел от gateway этого процесса: его проверил GatewayInterceptor
+func fromGateway(ctx context.Context) bool {
+	marked, _ := ctx.Value(gatewayKey{}).(bool)
+	return marked
+}
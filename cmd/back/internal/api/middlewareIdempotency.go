@@ -0,0 +1,209 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+	"twitter/internal/logger"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	// idempotencyMetadataKey ключ метаданных gRPC, gateway заполняет его из заголовка Idempotency-Key
+	idempotencyMetadataKey = "idempotency-key"
+	// idempotencyReplayedKey заголовок ответа, которым помечается повторно отданный ответ
+	idempotencyReplayedKey = "idempotent-replayed"
+	maxIdempotencyKeyLen   = 255
+	// idempotencyLockTTL время жизни отметки о запросе в работе. Если процесс упал посреди запроса,
+	// ключ освободится через это время, а не через полный TTL
+	idempotencyLockTTL = time.Minute
+	// idempotencyStoreTimeout дедлайн записи результата. Результат пишется и после отмены запроса:
+	// клиент, который не дождался ответа, придет за ним с повтором
+	idempotencyStoreTimeout = 2 * time.Second
+)
+
+type IdempotencyStore interface {
+	SetIfNotExists(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error)
+	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error
+	Get(ctx context.Context, key string) (string, error)
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// idempotencyRecord запись о запросе с ключом идемпотентности. Пустой Response значит, что запрос еще выполняется
+type idempotencyRecord struct {
+	RequestHash string `json:"request_hash"`
+	Response    []byte `json:"response,omitempty"`
+}
+
+// IdempotencyInterceptor для gRPC. Для перечисленных методов запоминает первый успешный ответ
+// на ключ идемпотентности пользователя и отдает его на повторы с тем же телом запроса.
+// Повтор с другим телом получает AlreadyExists, повтор во время выполнения первого запроса - Aborted.
+// Запросы без ключа проходят как обычно. При недоступном хранилище ключ игнорируется.
+// Должен стоять после AuthInterceptor: ключи разделены по пользователям
+func IdempotencyInterceptor(store IdempotencyStore, ttl time.Duration, methods ...string) grpc.UnaryServerInterceptor {
+	enabled := make(map[string]bool, len(methods))
+	for _, m := range methods {
+		enabled[m] = true
+	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !enabled[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		key := idempotencyKeyFromContext(ctx)
+		if key == "" {
+			return handler(ctx, req)
+		}
+		if len(key) > maxIdempotencyKeyLen {
+			return nil, status.Errorf(codes.InvalidArgument, "idempotency key is longer than %d", maxIdempotencyKeyLen)
+		}
+		userId, err := GetUserIDFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		message, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+		hash, err := requestHash(message)
+		if err != nil {
+			return nil, fmt.Errorf("requestHash: %w", err)
+		}
+
+		storeKey := "idempotency:" + userId + ":" + info.FullMethod + ":" + key
+		pending, err := json.Marshal(idempotencyRecord{RequestHash: hash})
+		if err != nil {
+			return nil, err
+		}
+		acquired, err := store.SetIfNotExists(ctx, storeKey, pending, idempotencyLockTTL)
+		if err != nil {
+			logger.FromContext(ctx).WarnContext(ctx, "idempotency lock failed, request not deduplicated", "error", err)
+			return handler(ctx, req)
+		}
+		if !acquired {
+			return replayIdempotent(ctx, store, storeKey, hash)
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			// неуспешный запрос можно повторить с тем же ключом
+			if delErr := withStoreTimeout(ctx, func(storeCtx context.Context) error {
+				return store.Delete(storeCtx, storeKey)
+			}); delErr != nil {
+				logger.FromContext(ctx).WarnContext(ctx, "idempotency key release failed", "error", delErr)
+			}
+			return nil, err
+		}
+
+		if err := withStoreTimeout(ctx, func(storeCtx context.Context) error {
+			return saveIdempotent(storeCtx, store, storeKey, hash, resp, ttl)
+		}); err != nil {
+			logger.FromContext(ctx).WarnContext(ctx, "idempotency response save failed", "error", err)
+			// запрос выполнен, но ответ не сохранен: продлеваем отметку о работе на полный TTL,
+			// чтобы повтор получил Aborted, а не выполнил запрос второй раз
+			if err := withStoreTimeout(ctx, func(storeCtx context.Context) error {
+				return store.Set(storeCtx, storeKey, pending, ttl)
+			}); err != nil {
+				logger.FromContext(ctx).ErrorContext(ctx, "idempotency key extend failed, retry may repeat the request", "error", err)
+			}
+		}
+		return resp, nil
+	}
+}
+
+// withStoreTimeout выполняет fn без отмены запроса, но со своим дедлайном idempotencyStoreTimeout
+func withStoreTimeout(ctx context.Context, fn func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), idempotencyStoreTimeout)
+	defer cancel()
+	return fn(ctx)
+}
+
+func replayIdempotent(ctx context.Context, store IdempotencyStore, storeKey string, hash string) (interface{}, error) {
+	raw, err := store.Get(ctx, storeKey)
+	if err != nil {
+		// запись успела истечь или удалиться после ошибки первого запроса
+		return nil, status.Error(codes.Aborted, "request with this idempotency key is being retried, try again")
+	}
+	var record idempotencyRecord
+	if err := json.Unmarshal([]byte(raw), &record); err != nil {
+		return nil, fmt.Errorf("idempotency record: %w", err)
+	}
+	if record.RequestHash != hash {
+		return nil, status.Error(codes.AlreadyExists, "idempotency key was already used with a different request")
+	}
+	if len(record.Response) == 0 {
+		return nil, status.Error(codes.Aborted, "request with this idempotency key is still in progress")
+	}
+
+	var stored anypb.Any
+	if err := proto.Unmarshal(record.Response, &stored); err != nil {
+		return nil, fmt.Errorf("idempotency response: %w", err)
+	}
+	resp, err := stored.UnmarshalNew()
+	if err != nil {
+		return nil, fmt.Errorf("idempotency response: %w", err)
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs(idempotencyReplayedKey, "true")); err != nil {
+		logger.FromContext(ctx).WarnContext(ctx, "set idempotency header failed", "error", err)
+	}
+	return resp, nil
+}
+
+func saveIdempotent(ctx context.Context, store IdempotencyStore, storeKey string, hash string, resp interface{},
+	ttl time.Duration) error {
+	message, ok := resp.(proto.Message)
+	if !ok {
+		return fmt.Errorf("response %T is not a proto message", resp)
+	}
+	stored, err := anypb.New(message)
+	if err != nil {
+		return err
+	}
+	raw, err := proto.Marshal(stored)
+	if err != nil {
+		return err
+	}
+	record, err := json.Marshal(idempotencyRecord{RequestHash: hash, Response: raw})
+	if err != nil {
+		return err
+	}
+	return store.Set(ctx, storeKey, record, ttl)
+}
+
+func requestHash(message proto.Message) (string, error) {
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func idempotencyKeyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(idempotencyMetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// IdempotencyMetadata передает заголовок Idempotency-Key из HTTP-запроса в метаданные gRPC
+func IdempotencyMetadata(ctx context.Context, r *http.Request) metadata.MD {
+	if key := r.Header.Get("Idempotency-Key"); key != "" {
+		return metadata.Pairs(idempotencyMetadataKey, key)
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/golang-jwt/jwt/v4"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
@@ -24,46 +25,101 @@ const (
 	UserIDKey contextKey = "user_id"
 )
 
-// JWTConfig конфигурация для JWT
-type JWTConfig struct {
-	SecretKey string
+// SecretSource возвращает текущий секрет JWT. Секрет читается на каждый запрос,
+// поэтому замена секрета при перезагрузке конфига применяется сразу
+type SecretSource func() string
+
+// PublicMethods методы gRPC, доступные без токена. Если токен все же передан, он проверяется
+// и пользователь попадает в контекст
+type PublicMethods map[string]bool
+
+func NewPublicMethods(methods ...string) PublicMethods {
+	public := make(PublicMethods, len(methods))
+	for _, m := range methods {
+		public[m] = true
+	}
+	return public
+}
+
+// DefaultPublicMethods проверки здоровья доступны оркестратору без токена
+var DefaultPublicMethods = []string{
+	grpc_health_v1.Health_Check_FullMethodName,
+	grpc_health_v1.Health_List_FullMethodName,
+	grpc_health_v1.Health_Watch_FullMethodName,
+}
+
+func (p PublicMethods) isPublicMethod(method string) bool {
+	return p[method]
+}
+
+// authorize аутентифицирует вызов. Для публичного метода без токена возвращает исходный контекст
+func (p PublicMethods) authorize(ctx context.Context, method string, jwtSecret SecretSource) (context.Context, error) {
+	if p.isPublicMethod(method) && !hasAuthorization(ctx) {
+		return ctx, nil
+	}
+	return authenticate(ctx, jwtSecret())
 }
 
 // AuthInterceptor для gRPC
-func AuthInterceptor(jwtSecret string) grpc.UnaryServerInterceptor {
+func AuthInterceptor(jwtSecret SecretSource, public PublicMethods) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		// Пропускаем некоторые методы (например, health check)
-		// if isPublicMethod(info.FullMethod) {
-		// 	return handler(ctx, req)
-		// }
-
-		// Извлекаем токен из метаданных
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "metadata is not provided")
-		}
-
-		authHeaders := md["authorization"]
-		if len(authHeaders) == 0 {
-			return nil, status.Error(codes.Unauthenticated, "authorization token is not provided")
+		ctx, err := public.authorize(ctx, info.FullMethod, jwtSecret)
+		if err != nil {
+			return nil, err
 		}
 
-		token := strings.TrimPrefix(authHeaders[0], "Bearer ")
+		return handler(ctx, req)
+	}
+}
 
-		// Валидируем токен
-		claims, err := ValidateToken(token, jwtSecret)
+// AuthStreamInterceptor для потоковых методов gRPC
+func AuthStreamInterceptor(jwtSecret SecretSource, public PublicMethods) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := public.authorize(ss.Context(), info.FullMethod, jwtSecret)
 		if err != nil {
-			return nil, status.Error(codes.Unauthenticated, fmt.Sprintf("invalid token: %v", err))
+			return err
 		}
 
-		if claims.UserID == "" {
-			// return nil, status.Error(codes.Unauthenticated, "user_id empty, invalid token")
-			return nil, fmt.Errorf("user_id empty, invalid token")
-		}
-		ctx = context.WithValue(ctx, UserIDKey, claims.UserID)
+		return handler(srv, &wrappedStream{ServerStream: ss, ctx: ctx})
+	}
+}
 
-		return handler(ctx, req)
+// wrappedStream подменяет контекст потока
+type wrappedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (w *wrappedStream) Context() context.Context {
+	return w.ctx
+}
+
+// authenticate проверяет токен из метаданных и кладет user_id в контекст
+func authenticate(ctx context.Context, jwtSecret string) (context.Context, error) {
+	// Извлекаем токен из метаданных
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "metadata is not provided")
+	}
+
+	authHeaders := md["authorization"]
+	if len(authHeaders) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "authorization token is not provided")
 	}
+
+	token := strings.TrimPrefix(authHeaders[0], "Bearer ")
+
+	// Валидируем токен
+	claims, err := ValidateToken(token, jwtSecret)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, fmt.Sprintf("invalid token: %v", err))
+	}
+
+	if claims.UserID == "" {
+		return nil, status.Error(codes.Unauthenticated, "user_id empty, invalid token")
+	}
+	setRequestUser(ctx, claims.UserID)
+	return context.WithValue(ctx, UserIDKey, claims.UserID), nil
 }
 
 // ValidateToken проверяет и расшифровывает JWT токен
@@ -88,25 +144,17 @@ func ValidateToken(tokenString, secretKey string) (*Claims, error) {
 	return claims, nil
 }
 
-// func isPublicMethod(method string) bool {
-// 	publicMethods := []string{
-// 		"/grpc.health.v1.Health/Check",
-// 		// добавьте другие публичные методы при необходимости
-// 	}
-
-// 	for _, m := range publicMethods {
-// 		if method == m {
-// 			return true
-// 		}
-// 	}
-// 	return false
-// }
-
-// GetUserIDFromContext извлекает user_id из контекста
+func hasAuthorization(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	return ok && len(md["authorization"]) > 0
+}
+
+// GetUserIDFromContext извлекает user_id из контекста. Вызов без токена, например метода из
+// public_methods, получает Unauthenticated
 func GetUserIDFromContext(ctx context.Context) (string, error) {
 	userID, ok := ctx.Value(UserIDKey).(string)
 	if !ok {
-		return "", fmt.Errorf("user_id not found in context")
+		return "", status.Error(codes.Unauthenticated, "user_id not found in context")
 	}
 	return userID, nil
 }
@@ -0,0 +1,151 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"twitter/internal/limiter"
+	"twitter/internal/logger"
+	"twitter/internal/requestid"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// overloadRetryDelay через сколько клиенту предлагается повторить отклоненный запрос
+const overloadRetryDelay = time.Second
+
+// readMethodPrefixes методы gRPC без побочных эффектов
+var readMethodPrefixes = []string{"Get", "List", "Batch"}
+
+func isReadMethod(fullMethod string) bool {
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, prefix := range readMethodPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// requestPriority чтения важнее записей, аутентифицированные пользователи важнее анонимных
+func requestPriority(read bool, authenticated bool) limiter.Priority {
+	switch {
+	case read && authenticated:
+		return limiter.PriorityHigh
+	case read || authenticated:
+		return limiter.PriorityNormal
+	}
+	return limiter.PriorityLow
+}
+
+func overloadedError() error {
+	st := status.New(codes.Unavailable, "server is overloaded")
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(overloadRetryDelay)})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// isOverloadCode коды, которыми заканчиваются запросы к перегруженному сервису или зависимости
+func isOverloadCode(code codes.Code) bool {
+	return code == codes.Unavailable || code == codes.DeadlineExceeded
+}
+
+// LoadSheddingInterceptor отклоняет запросы сверх адаптивного лимита с Unavailable. Проверки
+// здоровья не ограничиваются, вызовы gateway тоже: они уже прошли LoadSheddingMiddleware.
+// Должен стоять после AuthInterceptor, чтобы знать пользователя
+func LoadSheddingInterceptor(l *limiter.Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if isHealthMethod(info.FullMethod) || fromGateway(ctx) {
+			return handler(ctx, req)
+		}
+		_, err := GetUserIDFromContext(ctx)
+		release, ok := l.Acquire(requestPriority(isReadMethod(info.FullMethod), err == nil))
+		if !ok {
+			logger.FromContext(ctx).WarnContext(ctx, "request shed", "limit", l.Limit())
+			return nil, overloadedError()
+		}
+
+		resp, err := handler(ctx, req)
+		release(isOverloadCode(status.Code(err)))
+		return resp, err
+	}
+}
+
+// LoadSheddingStreamInterceptor то же для потоков. Длительность потока задает клиент, поэтому
+// лимит снижается только по кодам перегрузки. Должен стоять после AuthStreamInterceptor
+func LoadSheddingStreamInterceptor(l *limiter.Limiter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+		if isHealthMethod(info.FullMethod) || fromGateway(ctx) {
+			return handler(srv, ss)
+		}
+		_, err := GetUserIDFromContext(ctx)
+		release, ok := l.AcquireUntimed(requestPriority(isReadMethod(info.FullMethod), err == nil))
+		if !ok {
+			logger.FromContext(ctx).WarnContext(ctx, "request shed", "limit", l.Limit())
+			return overloadedError()
+		}
+
+		err = handler(srv, ss)
+		release(isOverloadCode(status.Code(err)))
+		return err
+	}
+}
+
+func isHealthMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/"+grpc_health_v1.Health_ServiceDesc.ServiceName+"/")
+}
+
+// LoadSheddingMiddleware то же для gateway: отвечает 503 до обращения к gRPC. Пользователь
+// определяется по JWT, чтение - по методу HTTP. Загрузка и раздача медиа занимают место,
+// но их длительность зависит от канала клиента и не влияет на лимит
+func LoadSheddingMiddleware(l *limiter.Limiter, jwtSecret SecretSource) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			read := r.Method == http.MethodGet || r.Method == http.MethodHead
+			authenticated := strings.HasPrefix(httpPrincipal(r, jwtSecret), "user:")
+			acquire := l.Acquire
+			if isMediaPath(r.URL.Path) {
+				acquire = l.AcquireUntimed
+			}
+			release, ok := acquire(requestPriority(read, authenticated))
+			if !ok {
+				logger.FromContext(r.Context()).WarnContext(r.Context(), "request shed", "limit", l.Limit())
+				writeOverloaded(w, r)
+				return
+			}
+
+			rw := &responseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+			next.ServeHTTP(rw, r)
+			release(rw.statusCode == http.StatusServiceUnavailable || rw.statusCode == http.StatusGatewayTimeout)
+		})
+	}
+}
+
+// isMediaPath пути загрузки и раздачи медиа: POST /media и GET /media/{id}[/thumbnail]
+func isMediaPath(path string) bool {
+	return path == "/media" || strings.HasPrefix(path, "/media/")
+}
+
+func writeOverloaded(w http.ResponseWriter, r *http.Request) {
+	st := withRequestInfo(status.Convert(overloadedError()), requestid.FromContext(r.Context()))
+	w.Header().Set("Retry-After", strconv.FormatInt(retryAfterSeconds(overloadRetryDelay), 10))
+	body, err := protojson.Marshal(st.Proto())
+	if err != nil {
+		http.Error(w, st.Message(), http.StatusServiceUnavailable)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusServiceUnavailable)
+	w.Write(body)
+}
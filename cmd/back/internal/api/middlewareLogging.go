@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+	"twitter/internal/logger"
+	"twitter/internal/requestid"
+
+	"google.golang.org/grpc"
+)
+
+type requestUserKey struct{}
+
+// requestUser пользователь запроса. Логгер создается до аутентификации, поэтому user_id
+// заполняется позже и читается в момент записи
+type requestUser struct {
+	id string
+}
+
+func (u *requestUser) LogValue() slog.Value {
+	return slog.StringValue(u.id)
+}
+
+// setRequestUser запоминает аутентифицированного пользователя для логгера запроса
+func setRequestUser(ctx context.Context, userId string) {
+	if u, ok := ctx.Value(requestUserKey{}).(*requestUser); ok {
+		u.id = userId
+	}
+}
+
+// withRequestLogger кладет в контекст логгер с request_id, method и user_id
+func withRequestLogger(ctx context.Context, base *slog.Logger, method string) context.Context {
+	user := &requestUser{}
+	ctx = context.WithValue(ctx, requestUserKey{}, user)
+	return logger.NewContext(ctx, base.With("request_id", requestid.FromContext(ctx), "method", method, "user_id", user))
+}
+
+// LoggingInterceptor ставится сразу после RequestIDInterceptor, чтобы логгер запроса видели
+// остальные перехватчики и обработчики через logger.FromContext
+func LoggingInterceptor(base *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(withRequestLogger(ctx, base, info.FullMethod), req)
+	}
+}
+
+// LoggingStreamInterceptor то же для потоковых методов
+func LoggingStreamInterceptor(base *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := withRequestLogger(ss.Context(), base, info.FullMethod)
+		return handler(srv, &wrappedStream{ServerStream: ss, ctx: ctx})
+	}
+}
@@ -0,0 +1,211 @@
+package api
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"twitter/internal/logger"
+	"twitter/internal/metrics"
+	"twitter/internal/requestid"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	rateLimitLimitKey     = "x-ratelimit-limit"
+	rateLimitRemainingKey = "x-ratelimit-remaining"
+	rateLimitResetKey     = "x-ratelimit-reset"
+	// defaultRateLimitKey правило для методов, у которых нет своего
+	defaultRateLimitKey = "default"
+)
+
+// RateLimit не больше Limit запросов за окно Window. Нулевой Limit отключает ограничение
+type RateLimit struct {
+	Limit  int64
+	Window time.Duration
+}
+
+type RateLimitStore interface {
+	IncrementWindow(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error)
+}
+
+type rateLimitDecision struct {
+	limit     int64
+	remaining int64
+	reset     time.Duration
+	allowed   bool
+}
+
+// checkRateLimit считает запрос в окне scope и principal. Ошибку хранилища вызывающий
+// должен трактовать как разрешение: без Redis сервис работает без ограничений
+func checkRateLimit(ctx context.Context, store RateLimitStore, scope string, principal string,
+	rule RateLimit) (rateLimitDecision, error) {
+	count, reset, err := store.IncrementWindow(ctx, "ratelimit:"+scope+":"+principal, rule.Window)
+	if err != nil {
+		return rateLimitDecision{}, err
+	}
+	return rateLimitDecision{
+		limit:     rule.Limit,
+		remaining: max(rule.Limit-count, 0),
+		reset:     reset,
+		allowed:   count <= rule.Limit,
+	}, nil
+}
+
+// exhaustedError ResourceExhausted с RetryInfo, по которому клиент понимает, когда повторить запрос
+func (d rateLimitDecision) exhaustedError() error {
+	st := status.New(codes.ResourceExhausted, "rate limit exceeded")
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(d.reset)})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// RateLimitInterceptor для gRPC. Правило ищется по имени метода (например, CreateTweet),
+// затем берется правило default. Счетчики ведутся отдельно для каждого пользователя,
+// а без пользователя в контексте - для адреса клиента. Должен стоять после AuthInterceptor
+func RateLimitInterceptor(store RateLimitStore, limits map[string]RateLimit) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		// пробы оркестратора не должны упираться в лимит
+		if strings.HasPrefix(info.FullMethod, "/grpc.health.v1.") {
+			return handler(ctx, req)
+		}
+		method := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+		rule, ok := limits[method]
+		if !ok {
+			method = defaultRateLimitKey
+			rule = limits[defaultRateLimitKey]
+		}
+		if rule.Limit <= 0 || rule.Window <= 0 {
+			return handler(ctx, req)
+		}
+
+		decision, err := checkRateLimit(ctx, store, method, grpcPrincipal(ctx), rule)
+		if err != nil {
+			logger.FromContext(ctx).WarnContext(ctx, "rate limit check failed, request allowed", "scope", method, "error", err)
+			return handler(ctx, req)
+		}
+		err = grpc.SetHeader(ctx, metadata.Pairs(
+			rateLimitLimitKey, strconv.FormatInt(decision.limit, 10),
+			rateLimitRemainingKey, strconv.FormatInt(decision.remaining, 10),
+			rateLimitResetKey, strconv.FormatInt(retryAfterSeconds(decision.reset), 10),
+		))
+		if err != nil {
+			logger.FromContext(ctx).WarnContext(ctx, "set rate limit headers failed", "error", err)
+		}
+		if !decision.allowed {
+			metrics.RateLimitedTotal.WithLabelValues(method).Inc()
+			return nil, decision.exhaustedError()
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// RateLimitMiddleware общий лимит на все запросы gateway, в том числе на раздачу медиа,
+// которая идет мимо gRPC. Пользователь определяется по JWT, без токена - по адресу клиента
+func RateLimitMiddleware(store RateLimitStore, rule RateLimit, jwtSecret SecretSource) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if rule.Limit <= 0 || rule.Window <= 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			decision, err := checkRateLimit(r.Context(), store, "http", httpPrincipal(r, jwtSecret), rule)
+			if err != nil {
+				logger.FromContext(r.Context()).WarnContext(r.Context(), "rate limit check failed, request allowed", "scope", "http", "error", err)
+				next.ServeHTTP(w, r)
+				return
+			}
+			// заголовки более узкого лимита метода, если он есть, заменят эти в ответе gateway
+			setRateLimitHeaders(w, metadata.Pairs(
+				rateLimitLimitKey, strconv.FormatInt(decision.limit, 10),
+				rateLimitRemainingKey, strconv.FormatInt(decision.remaining, 10),
+				rateLimitResetKey, strconv.FormatInt(retryAfterSeconds(decision.reset), 10),
+			))
+			if decision.allowed {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			metrics.RateLimitedTotal.WithLabelValues("http").Inc()
+			w.Header().Set("Retry-After", strconv.FormatInt(retryAfterSeconds(decision.reset), 10))
+			st := withRequestInfo(status.Convert(decision.exhaustedError()), requestid.FromContext(r.Context()))
+			body, err := protojson.Marshal(st.Proto())
+			if err != nil {
+				http.Error(w, st.Message(), http.StatusTooManyRequests)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write(body)
+		})
+	}
+}
+
+// setRateLimitHeaders переносит счетчики лимита из метаданных в заголовки X-RateLimit-*
+func setRateLimitHeaders(w http.ResponseWriter, md metadata.MD) {
+	for _, key := range []string{rateLimitLimitKey, rateLimitRemainingKey, rateLimitResetKey} {
+		if values := md.Get(key); len(values) > 0 {
+			w.Header().Set(key, values[0])
+		}
+	}
+}
+
+// isRateLimitHeader заголовки лимита выставляются отдельно, чтобы не дублировать значения middleware
+func isRateLimitHeader(key string) bool {
+	return strings.HasPrefix(strings.ToLower(key), "x-ratelimit-")
+}
+
+// retryAfterSeconds округляет вверх: клиент, повторивший запрос через это время, уже попадет в новое окно
+func retryAfterSeconds(d time.Duration) int64 {
+	return int64((d + time.Second - 1) / time.Second)
+}
+
+func grpcPrincipal(ctx context.Context) string {
+	if userId, err := GetUserIDFromContext(ctx); err == nil {
+		return "user:" + userId
+	}
+	// gateway добавляет адрес своего клиента последним в x-forwarded-for. Остальным клиентам
+	// заголовок не доверяется, иначе любой подменил бы свой адрес
+	if fromGateway(ctx) {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("x-forwarded-for"); len(values) > 0 {
+				hops := strings.Split(values[len(values)-1], ",")
+				return "ip:" + strings.TrimSpace(hops[len(hops)-1])
+			}
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		return "ip:" + hostOnly(p.Addr.String())
+	}
+	return "ip:unknown"
+}
+
+func httpPrincipal(r *http.Request, jwtSecret SecretSource) string {
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		claims, err := ValidateToken(strings.TrimPrefix(auth, "Bearer "), jwtSecret())
+		if err == nil && claims.UserID != "" {
+			return "user:" + claims.UserID
+		}
+	}
+	return "ip:" + hostOnly(r.RemoteAddr)
+}
+
+func hostOnly(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
@@ -0,0 +1,111 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"twitter/internal/logger"
+	"twitter/internal/requestid"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RequestIDMiddleware принимает X-Request-ID клиента или создает новый, возвращает его
+// в заголовке ответа и кладет в контекст запроса
+func RequestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := requestid.Ensure(r.Header.Get(requestid.Header))
+		w.Header().Set(requestid.Header, id)
+
+		ctx := requestid.NewContext(r.Context(), id)
+		ctx = logger.NewContext(ctx, logger.FromContext(ctx).With("request_id", id))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// RequestIDClientInterceptor передает идентификатор запроса gateway в метаданных вызова gRPC
+func RequestIDClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(outgoingRequestID(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// RequestIDStreamClientInterceptor то же для потоковых вызовов
+func RequestIDStreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+		streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(outgoingRequestID(ctx), desc, cc, method, opts...)
+	}
+}
+
+func outgoingRequestID(ctx context.Context) context.Context {
+	if id := requestid.FromContext(ctx); id != "" {
+		return metadata.AppendToOutgoingContext(ctx, requestid.MetadataKey, id)
+	}
+	return ctx
+}
+
+// RequestIDInterceptor принимает идентификатор запроса из метаданных или создает новый,
+// кладет его в контекст, возвращает в заголовке ответа и в RequestInfo ошибки
+func RequestIDInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		id := requestid.Ensure(requestIDFromMetadata(ctx))
+		ctx = requestid.NewContext(ctx, id)
+		if err := grpc.SetHeader(ctx, metadata.Pairs(requestid.MetadataKey, id)); err != nil {
+			logger.FromContext(ctx).WarnContext(ctx, "set request id header failed", "error", err)
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, withRequestInfo(status.Convert(err), id).Err()
+		}
+		return resp, nil
+	}
+}
+
+// RequestIDStreamInterceptor то же для потоковых методов
+func RequestIDStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		id := requestid.Ensure(requestIDFromMetadata(ss.Context()))
+		ctx := requestid.NewContext(ss.Context(), id)
+		if err := ss.SetHeader(metadata.Pairs(requestid.MetadataKey, id)); err != nil {
+			logger.FromContext(ctx).WarnContext(ctx, "set request id header failed", "error", err)
+		}
+
+		if err := handler(srv, &wrappedStream{ServerStream: ss, ctx: ctx}); err != nil {
+			return withRequestInfo(status.Convert(err), id).Err()
+		}
+		return nil
+	}
+}
+
+func requestIDFromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(requestid.MetadataKey); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// withRequestInfo добавляет в ошибку RequestInfo с идентификатором запроса, если его там еще нет
+func withRequestInfo(st *status.Status, id string) *status.Status {
+	if id == "" {
+		return st
+	}
+	for _, detail := range st.Details() {
+		if _, ok := detail.(*errdetails.RequestInfo); ok {
+			return st
+		}
+	}
+	withInfo, err := st.WithDetails(&errdetails.RequestInfo{RequestId: id})
+	if err != nil {
+		return st
+	}
+	return withInfo
+}
@@ -0,0 +1,41 @@
+package api
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"twitter/cmd/back/internal/app"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// encodePageToken токен страницы: время создания последней записи и id твита
+func encodePageToken(c app.PageCursor) string {
+	raw := strconv.FormatInt(c.CreatedAt.UnixMicro(), 10) + ":" + c.TweetId.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodePageToken(token string) (*app.PageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+	micros, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, errors.New("malformed cursor")
+	}
+	us, err := strconv.ParseInt(micros, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	tweetId, err := uuid.FromString(id)
+	if err != nil {
+		return nil, err
+	}
+	return &app.PageCursor{CreatedAt: time.UnixMicro(us).UTC(), TweetId: tweetId}, nil
+}
@@ -0,0 +1,116 @@
+package api
+
+import (
+	"context"
+	"strconv"
+	"time"
+	pb "twitter/api/proto/v1"
+	"twitter/cmd/back/internal/app"
+	"twitter/internal/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// pollKey ключ счетчиков опроса, в одном слоте с твитом, см. tweetKey
+func pollKey(tweetId uuid.UUID) string {
+	return "poll:{" + tweetId.String() + "}"
+}
+
+// attachPolls подгружает опросы для твитов, полученных из базы
+func (s GrpcServer) attachPolls(ctx context.Context, tweets []app.Tweet) error {
+	if len(tweets) == 0 {
+		return nil
+	}
+	ids := make([]uuid.UUID, len(tweets))
+	for i := range tweets {
+		ids[i] = tweets[i].Id
+	}
+	polls, err := s.Database.GetPollsFromDB(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for i := range tweets {
+		if poll, ok := polls[tweets[i].Id]; ok {
+			tweets[i].Poll = &poll
+		}
+	}
+	return nil
+}
+
+// refreshPollVotes подставляет в опрос актуальные результаты из счетчиков Redis.
+// Если счетчиков нет, они восстанавливаются из Postgres
+func (s GrpcServer) refreshPollVotes(ctx context.Context, poll *app.Poll) {
+	if poll == nil {
+		return
+	}
+	key := pollKey(poll.TweetId)
+
+	votes, err := s.CacheDBPolls.GetFields(ctx, key)
+	if err == nil && len(votes) > 0 {
+		for i := range poll.Options {
+			n, err := strconv.ParseInt(votes[strconv.Itoa(int(poll.Options[i].Position))], 10, 64)
+			if err == nil {
+				poll.Options[i].Votes = n
+			}
+		}
+		return
+	}
+
+	polls, err := s.Database.GetPollsFromDB(ctx, []uuid.UUID{poll.TweetId})
+	if err != nil {
+		logger.FromContext(ctx).ErrorContext(ctx, "poll votes refresh failed", "tweet_id", poll.TweetId.String(), "error", err)
+		return
+	}
+	fresh, ok := polls[poll.TweetId]
+	if !ok {
+		return
+	}
+	*poll = fresh
+
+	values := make(map[string]interface{}, len(poll.Options))
+	for _, option := range poll.Options {
+		values[strconv.Itoa(int(option.Position))] = option.Votes
+	}
+	ttl := s.Settings.ClosedPollVotesTTL()
+	if !poll.Closed(time.Now()) {
+		ttl = min(s.Settings.PollVotesTTL(), time.Until(poll.ClosesAt))
+	}
+	if err := s.CacheDBPolls.SetFields(ctx, key, values, ttl); err != nil {
+		logger.FromContext(ctx).WarnContext(ctx, "cache set failed", "cache", "polls", "key", key, "error", err)
+	}
+}
+
+func fromCreatePoll(p *pb.CreatePoll) *app.Poll {
+	if p == nil {
+		return nil
+	}
+	poll := &app.Poll{
+		Options:  make([]app.PollOption, len(p.Options)),
+		ClosesAt: p.ClosesAt.AsTime(),
+	}
+	for i, text := range p.Options {
+		poll.Options[i] = app.PollOption{Position: int32(i), Text: text}
+	}
+	return poll
+}
+
+func toPoll(p *app.Poll) *pb.Poll {
+	if p == nil {
+		return nil
+	}
+	poll := &pb.Poll{
+		Options:  make([]*pb.PollOption, len(p.Options)),
+		ClosesAt: timestamppb.New(p.ClosesAt),
+		Closed:   p.Closed(time.Now()),
+	}
+	for i, option := range p.Options {
+		poll.Options[i] = &pb.PollOption{
+			Position: option.Position,
+			Text:     option.Text,
+			Votes:    option.Votes,
+		}
+		poll.TotalVotes += option.Votes
+	}
+	return poll
+}
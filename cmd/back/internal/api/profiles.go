@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	pb "twitter/api/proto/v1"
+	"twitter/cmd/back/internal/app"
+	"twitter/internal/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s GrpcServer) GetProfile(ctx context.Context, request *pb.GetProfileRequest) (*pb.GetProfileResponse, error) {
+
+	if err := request.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	profile, err := s.Database.GetProfileFromDB(ctx, uuid.FromStringOrNil(request.UserId))
+	if errors.Is(err, app.ErrProfileNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("GetProfileFromDB: %w", err)
+	}
+
+	return &pb.GetProfileResponse{Profile: toProfile(profile)}, nil
+}
+
+func (s GrpcServer) GetProfileByUsername(ctx context.Context, request *pb.GetProfileByUsernameRequest) (*pb.GetProfileByUsernameResponse, error) {
+
+	if err := request.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	profile, err := s.Database.GetProfileByUsernameFromDB(ctx, request.Username)
+	if errors.Is(err, app.ErrProfileNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("GetProfileByUsernameFromDB: %w", err)
+	}
+
+	return &pb.GetProfileByUsernameResponse{Profile: toProfile(profile)}, nil
+}
+
+func (s GrpcServer) UpdateProfile(ctx context.Context, request *pb.UpdateProfileRequest) (*pb.UpdateProfileResponse, error) {
+
+	userId, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	profile, err := s.Database.UpsertProfileToDB(ctx, app.Profile{
+		UserId:      uuid.FromStringOrNil(userId),
+		Username:    request.Username,
+		DisplayName: request.DisplayName,
+		Bio:         request.Bio,
+		AvatarURL:   request.AvatarUrl,
+		Location:    request.Location,
+		Website:     request.Website,
+	})
+	if errors.Is(err, app.ErrUsernameTaken) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("UpsertProfileToDB: %w", err)
+	}
+
+	return &pb.UpdateProfileResponse{Profile: toProfile(profile)}, nil
+}
+
+// attachAuthors встраивает в твиты краткий профиль автора. Профиль не обязателен,
+// поэтому при ошибке базы твиты отдаются без авторов
+func (s GrpcServer) attachAuthors(ctx context.Context, tweets []*pb.Tweet) {
+	if len(tweets) == 0 {
+		return
+	}
+	ids := make([]uuid.UUID, 0, len(tweets))
+	seen := make(map[uuid.UUID]bool, len(tweets))
+	for _, t := range tweets {
+		id := uuid.FromStringOrNil(t.UserId)
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	profiles, err := s.Database.GetProfilesByIDsFromDB(ctx, ids)
+	if err != nil {
+		logger.FromContext(ctx).ErrorContext(ctx, "load tweet authors failed", "error", err)
+		return
+	}
+	for _, t := range tweets {
+		if p, ok := profiles[uuid.FromStringOrNil(t.UserId)]; ok {
+			t.Author = &pb.Author{
+				UserId:      p.UserId.String(),
+				Username:    p.Username,
+				DisplayName: p.DisplayName,
+				AvatarUrl:   p.AvatarURL,
+			}
+		}
+	}
+}
+
+func toProfile(p app.Profile) *pb.Profile {
+	return &pb.Profile{
+		UserId:      p.UserId.String(),
+		Username:    p.Username,
+		DisplayName: p.DisplayName,
+		Bio:         p.Bio,
+		AvatarUrl:   p.AvatarURL,
+		Location:    p.Location,
+		Website:     p.Website,
+		CreatedAt:   timestamppb.New(p.CreatedAt),
+		UpdatedAt:   timestamppb.New(p.UpdatedAt),
+	}
+}
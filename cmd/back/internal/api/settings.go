@@ -0,0 +1,81 @@
+package api
+
+import (
+	"slices"
+	"sync/atomic"
+	"time"
+)
+
+// RuntimeSettings снимок настроек, которые применяются без перезапуска по SIGHUP. Значения
+// по умолчанию подставляет config, здесь поля используются как есть
+type RuntimeSettings struct {
+	JwtSecret          string
+	TweetCacheTTL      time.Duration
+	TweetNotFoundTTL   time.Duration
+	PollVotesTTL       time.Duration
+	ClosedPollVotesTTL time.Duration
+	UserTimelineMaxLen int
+	UserTimelineTTL    time.Duration
+	AdminUserIds       []string
+	// ConfigVersion номер загрузки конфига: 1 при старте, +1 на каждую успешную перезагрузку
+	ConfigVersion  int64
+	ConfigChecksum string
+	ConfigLoadedAt time.Time
+}
+
+// Settings общий для сервера и middleware источник настроек. Update заменяет снимок целиком,
+// поэтому читатели всегда видят согласованный набор значений
+type Settings struct {
+	current atomic.Pointer[RuntimeSettings]
+}
+
+func NewSettings(rs RuntimeSettings) *Settings {
+	s := &Settings{}
+	s.Update(rs)
+	return s
+}
+
+func (s *Settings) Update(rs RuntimeSettings) {
+	s.current.Store(&rs)
+}
+
+// Get возвращает текущий снимок. Для nil Settings отдается пустой снимок
+func (s *Settings) Get() RuntimeSettings {
+	if s == nil {
+		return RuntimeSettings{}
+	}
+	return *s.current.Load()
+}
+
+// JwtSecret подходит как источник секрета для AuthInterceptor
+func (s *Settings) JwtSecret() string {
+	return s.Get().JwtSecret
+}
+
+func (s *Settings) TweetCacheTTL() time.Duration {
+	return s.Get().TweetCacheTTL
+}
+
+func (s *Settings) TweetNotFoundTTL() time.Duration {
+	return s.Get().TweetNotFoundTTL
+}
+
+func (s *Settings) PollVotesTTL() time.Duration {
+	return s.Get().PollVotesTTL
+}
+
+func (s *Settings) ClosedPollVotesTTL() time.Duration {
+	return s.Get().ClosedPollVotesTTL
+}
+
+func (s *Settings) UserTimelineMaxLen() int {
+	return s.Get().UserTimelineMaxLen
+}
+
+func (s *Settings) UserTimelineTTL() time.Duration {
+	return s.Get().UserTimelineTTL
+}
+
+func (s *Settings) IsAdmin(userId string) bool {
+	return slices.Contains(s.Get().AdminUserIds, userId)
+}
@@ -0,0 +1,128 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"twitter/cmd/back/internal/app"
+	"twitter/cmd/back/internal/cache"
+	"twitter/internal/logger"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// Лента пользователя в CacheDBUserTweets - отсортированное множество id последних твитов
+// со временем создания в микросекундах в качестве счета, в том же порядке, что и в базе.
+// Сами твиты читаются через кэш CacheDBTweets, поэтому правка твита не требует обновления ленты
+
+func userTimelineKey(userId uuid.UUID) string {
+	return "user_tweets:{" + userId.String() + "}"
+}
+
+func timelineMember(tweet app.Tweet) cache.SortedMember {
+	return cache.SortedMember{Member: tweet.Id.String(), Score: float64(tweet.CreatedAt.UnixMicro())}
+}
+
+// getUserTimeline возвращает страницу твитов пользователя, новые первыми, и токен следующей
+// страницы. Первая страница читается из ленты в кэше, при промахе лента собирается из базы.
+// Следующие страницы и страницы больше ленты читаются из базы
+func (s GrpcServer) getUserTimeline(ctx context.Context, userId uuid.UUID, cursor *app.PageCursor, pageSize int) ([]app.Tweet, string, error) {
+	var (
+		tweets []app.Tweet
+		err    error
+	)
+	if cursor == nil && pageSize < s.Settings.UserTimelineMaxLen() {
+		tweets, err = s.getCachedUserTimeline(ctx, userId, pageSize+1)
+	} else {
+		tweets, err = s.Database.GetTweetsByUsersFromDB(ctx, []uuid.UUID{userId}, cursor, pageSize+1)
+		if err != nil {
+			return nil, "", fmt.Errorf("GetTweetsByUsersFromDB: %w", err)
+		}
+		if err := s.hydrateTweets(ctx, tweets); err != nil {
+			return nil, "", fmt.Errorf("hydrateTweets: %w", err)
+		}
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextPageToken string
+	if len(tweets) > pageSize {
+		tweets = tweets[:pageSize]
+		last := tweets[len(tweets)-1]
+		nextPageToken = encodePageToken(app.PageCursor{CreatedAt: last.CreatedAt, TweetId: last.Id})
+	}
+	return tweets, nextPageToken, nil
+}
+
+// getCachedUserTimeline возвращает до limit последних твитов пользователя через ленту в кэше
+func (s GrpcServer) getCachedUserTimeline(ctx context.Context, userId uuid.UUID, limit int) ([]app.Tweet, error) {
+	log := logger.FromContext(ctx)
+	key := userTimelineKey(userId)
+
+	var ids []uuid.UUID
+	members, err := s.CacheDBUserTweets.RangeSortedDesc(ctx, key, int64(limit))
+	switch {
+	case err == nil:
+		ids = make([]uuid.UUID, 0, len(members))
+		for _, m := range members {
+			ids = append(ids, uuid.FromStringOrNil(m))
+		}
+	case errors.Is(err, cache.ErrMiss):
+		log.DebugContext(ctx, "cache miss", "cache", "user_tweets", "key", key)
+	default:
+		if err := s.cacheOutage(err); err != nil {
+			return nil, err
+		}
+		log.WarnContext(ctx, "cache get failed, reading database", "cache", "user_tweets", "key", key, "error", err)
+	}
+
+	if err != nil {
+		refs, err := s.Database.GetUserTweetIDsFromDB(ctx, userId, s.Settings.UserTimelineMaxLen())
+		if err != nil {
+			return nil, fmt.Errorf("GetUserTweetIDsFromDB: %w", err)
+		}
+		ids = make([]uuid.UUID, 0, min(len(refs), limit))
+		timeline := make([]cache.SortedMember, len(refs))
+		for i, ref := range refs {
+			if i < limit {
+				ids = append(ids, ref.Id)
+			}
+			timeline[i] = timelineMember(ref)
+		}
+		// твит, созданный между чтением и записью, пропадет из ленты до истечения ее времени жизни.
+		// Лента без твитов тоже записывается, чтобы не ходить за ней в базу каждый раз
+		if err := s.CacheDBUserTweets.ReplaceSorted(ctx, key, timeline, s.Settings.UserTimelineTTL()); err != nil {
+			log.WarnContext(ctx, "cache set failed", "cache", "user_tweets", "key", key, "error", err)
+		}
+	}
+
+	found, err := s.getTweetsByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	tweets := make([]app.Tweet, 0, len(found))
+	for _, id := range ids {
+		if tweet, ok := found[id]; ok {
+			tweets = append(tweets, tweet)
+		}
+	}
+	return tweets, nil
+}
+
+// addToUserTimeline добавляет новый твит в ленту автора, если она уже есть в кэше
+func (s GrpcServer) addToUserTimeline(ctx context.Context, tweet app.Tweet) {
+	key := userTimelineKey(tweet.UserId)
+	err := s.CacheDBUserTweets.AddSortedIfExists(ctx, key, timelineMember(tweet), int64(s.Settings.UserTimelineMaxLen()))
+	if err != nil {
+		logger.FromContext(ctx).WarnContext(ctx, "cache update failed", "cache", "user_tweets", "key", key, "error", err)
+	}
+}
+
+// removeFromUserTimeline убирает удаленный твит из ленты автора
+func (s GrpcServer) removeFromUserTimeline(ctx context.Context, tweet app.Tweet) {
+	key := userTimelineKey(tweet.UserId)
+	if err := s.CacheDBUserTweets.RemoveSorted(ctx, key, tweet.Id.String()); err != nil {
+		logger.FromContext(ctx).WarnContext(ctx, "cache delete failed", "cache", "user_tweets", "key", key, "error", err)
+	}
+}
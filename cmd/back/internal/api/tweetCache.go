@@ -0,0 +1,149 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"twitter/cmd/back/internal/app"
+	"twitter/cmd/back/internal/cache"
+	"twitter/internal/breaker"
+	"twitter/internal/logger"
+
+	"github.com/gofrs/uuid/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// tweetKey ключ твита в CacheDBTweets. Id в фигурных скобках - hash tag: в Redis Cluster твит
+// и счетчики его опроса попадают в один слот
+func tweetKey(id uuid.UUID) string {
+	return "tweet:{" + id.String() + "}"
+}
+
+// Поведение чтений при разомкнутом breaker Redis
+const (
+	// CacheFallbackSkip читать из базы в обход кэша
+	CacheFallbackSkip = "skip"
+	// CacheFallbackFail отвечать Unavailable, чтобы вся нагрузка чтения не ушла в базу
+	CacheFallbackFail = "fail"
+)
+
+// cacheOutage возвращает ошибку для клиента, если кэш отключен breaker и по настройке чтение
+// из базы не выполняется. nil - читать из базы
+func (s GrpcServer) cacheOutage(err error) error {
+	if s.CacheFallback == CacheFallbackFail && errors.Is(err, breaker.ErrOpen) {
+		return status.Error(codes.Unavailable, "cache unavailable")
+	}
+	return nil
+}
+
+// tweetNotFoundMarker значение в CacheDBTweets для id, которого нет в базе
+const tweetNotFoundMarker = "-"
+
+// getTweet читает твит через кэш. Промахи по одному id объединяются в один запрос к базе,
+// результат, в том числе отсутствие твита, записывается в кэш. Недоступный Redis не мешает
+// чтению из базы
+func (s GrpcServer) getTweet(ctx context.Context, id uuid.UUID) (app.Tweet, error) {
+	log := logger.FromContext(ctx)
+	key := tweetKey(id)
+
+	raw, err := s.CacheDBTweets.Get(ctx, key)
+	switch {
+	case err == nil && raw == tweetNotFoundMarker:
+		return app.Tweet{}, app.ErrTweetNotFound
+	case err == nil:
+		tweet, err := s.decodeTweet(ctx, key, raw)
+		if err == nil {
+			s.refreshPollVotes(ctx, tweet.Poll)
+			return tweet, nil
+		}
+	case errors.Is(err, cache.ErrMiss):
+		log.DebugContext(ctx, "cache miss", "cache", "tweets", "key", key)
+	default:
+		if err := s.cacheOutage(err); err != nil {
+			return app.Tweet{}, err
+		}
+		log.WarnContext(ctx, "cache get failed, reading database", "cache", "tweets", "key", key, "error", err)
+	}
+
+	if s.TweetLoads == nil {
+		return s.loadTweet(ctx, id)
+	}
+	// общий запрос не должен прерываться, если отменил запрос тот, кто его начал
+	v, err, _ := s.TweetLoads.Do(key, func() (interface{}, error) {
+		return s.loadTweet(context.WithoutCancel(ctx), id)
+	})
+	if err != nil {
+		return app.Tweet{}, err
+	}
+	return v.(app.Tweet), nil
+}
+
+// loadTweet читает твит из базы и кладет его в кэш
+func (s GrpcServer) loadTweet(ctx context.Context, id uuid.UUID) (app.Tweet, error) {
+	log := logger.FromContext(ctx)
+	key := tweetKey(id)
+
+	tweet, err := s.Database.GetTweetByIDFromDB(ctx, app.Tweet{Id: id})
+	if errors.Is(err, app.ErrTweetNotFound) {
+		if err := s.CacheDBTweets.Set(ctx, key, tweetNotFoundMarker, s.Settings.TweetNotFoundTTL()); err != nil {
+			log.WarnContext(ctx, "cache set failed", "cache", "tweets", "key", key, "error", err)
+		}
+		return app.Tweet{}, err
+	}
+	if err != nil {
+		return app.Tweet{}, fmt.Errorf("GetTweetByIDFromDB: %w", err)
+	}
+	tweets := []app.Tweet{tweet}
+	if err := s.hydrateTweets(ctx, tweets); err != nil {
+		return app.Tweet{}, fmt.Errorf("hydrateTweets: %w", err)
+	}
+	tweet = tweets[0]
+
+	s.cacheTweet(ctx, tweet)
+	return tweet, nil
+}
+
+func (s GrpcServer) tweetCodec() TweetCodec {
+	if s.TweetCodec == nil {
+		return cache.TweetCodec{}
+	}
+	return s.TweetCodec
+}
+
+// encodeTweet кодирует твит для кэша. Ошибка пишется в лог, твит тогда не кэшируется
+func (s GrpcServer) encodeTweet(ctx context.Context, tweet app.Tweet) ([]byte, bool) {
+	data, err := s.tweetCodec().Encode(tweet)
+	if err != nil {
+		logger.FromContext(ctx).ErrorContext(ctx, "tweet encode failed", "tweet_id", tweet.Id.String(), "error", err)
+		return nil, false
+	}
+	return data, true
+}
+
+// decodeTweet декодирует запись кэша. Запись устаревшего формата - обычный промах,
+// остальные ошибки пишутся в лог
+func (s GrpcServer) decodeTweet(ctx context.Context, key string, raw string) (app.Tweet, error) {
+	tweet, err := s.tweetCodec().Decode([]byte(raw))
+	log := logger.FromContext(ctx)
+	switch {
+	case errors.Is(err, cache.ErrStaleEntry):
+		log.DebugContext(ctx, "cache entry outdated", "cache", "tweets", "key", key)
+	case err != nil:
+		log.WarnContext(ctx, "cache decode failed", "cache", "tweets", "key", key, "error", err)
+	}
+	return tweet, err
+}
+
+// cacheTweet записывает твит в CacheDBTweets, если в кэше нет версии новее: чтение из базы,
+// начатое до UpdateTweet, не затрет результат обновления. Ошибки кэша на ответ не влияют
+func (s GrpcServer) cacheTweet(ctx context.Context, tweet app.Tweet) {
+	data, ok := s.encodeTweet(ctx, tweet)
+	if !ok {
+		return
+	}
+	key := tweetKey(tweet.Id)
+	if err := s.CacheDBTweets.SetVersioned(ctx, key, data, s.Settings.TweetCacheTTL()); err != nil {
+		logger.FromContext(ctx).WarnContext(ctx, "cache set failed", "cache", "tweets", "key", key, "error", err)
+	}
+}
@@ -0,0 +1,157 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	pb "twitter/api/proto/v1"
+	"twitter/cmd/back/internal/app"
+
+	"github.com/gofrs/uuid/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errSelfRelation = errors.New("cannot block or mute yourself")
+
+func (s GrpcServer) Block(ctx context.Context, request *pb.BlockRequest) (*pb.BlockResponse, error) {
+	userId, targetId, err := relationUsers(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Database.BlockUserToDB(ctx, userId, targetId); err != nil {
+		return nil, fmt.Errorf("BlockUserToDB: %w", err)
+	}
+	return &pb.BlockResponse{}, nil
+}
+
+func (s GrpcServer) Unblock(ctx context.Context, request *pb.UnblockRequest) (*pb.UnblockResponse, error) {
+	userId, targetId, err := relationUsers(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Database.UnblockUserFromDB(ctx, userId, targetId); err != nil {
+		return nil, fmt.Errorf("UnblockUserFromDB: %w", err)
+	}
+	return &pb.UnblockResponse{}, nil
+}
+
+func (s GrpcServer) Mute(ctx context.Context, request *pb.MuteRequest) (*pb.MuteResponse, error) {
+	userId, targetId, err := relationUsers(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Database.MuteUserToDB(ctx, userId, targetId); err != nil {
+		return nil, fmt.Errorf("MuteUserToDB: %w", err)
+	}
+	return &pb.MuteResponse{}, nil
+}
+
+func (s GrpcServer) Unmute(ctx context.Context, request *pb.UnmuteRequest) (*pb.UnmuteResponse, error) {
+	userId, targetId, err := relationUsers(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Database.UnmuteUserFromDB(ctx, userId, targetId); err != nil {
+		return nil, fmt.Errorf("UnmuteUserFromDB: %w", err)
+	}
+	return &pb.UnmuteResponse{}, nil
+}
+
+// relationUsers возвращает текущего пользователя и цель блокировки или скрытия
+func relationUsers(ctx context.Context, target string) (uuid.UUID, uuid.UUID, error) {
+	userId, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	targetId, err := uuid.FromString(target)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+	if targetId.String() == userId {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, errSelfRelation.Error())
+	}
+	return uuid.FromStringOrNil(userId), targetId, nil
+}
+
+// presentTweets единая точка выдачи твитов читателю. Через нее проходят все ручки чтения:
+// она убирает твиты авторов, которых читатель заблокировал или скрыл, авторов, заблокировавших
+// читателя, и защищенных аккаунтов без одобренного доступа, затем переводит твиты в pb
+// с авторами и проставляет персональные флаги. Порядок твитов сохраняется.
+// Проверка идет после чтения из кэша, поэтому общий кэш твитов по id не раскрывает защищенные твиты
+func (s GrpcServer) presentTweets(ctx context.Context, tweets []app.Tweet) ([]*pb.Tweet, error) {
+	visible, err := s.filterVisible(ctx, tweets)
+	if err != nil {
+		return nil, err
+	}
+
+	pbTweets := make([]*pb.Tweet, len(visible))
+	for i := range visible {
+		pbTweets[i] = toTweet(visible[i])
+	}
+	s.markBookmarked(ctx, pbTweets)
+	s.attachAuthors(ctx, pbTweets)
+	return pbTweets, nil
+}
+
+// filterVisible при ошибке базы не отдает ничего: лучше отказать, чем показать твит заблокировавшего автора.
+// Вызов без токена (метод из public_methods) считается анонимным читателем: ему видны только
+// твиты незащищенных аккаунтов
+func (s GrpcServer) filterVisible(ctx context.Context, tweets []app.Tweet) ([]app.Tweet, error) {
+	// uuid.Nil не совпадает ни с одним автором, блокировкой или одобренным доступом
+	viewerId := uuid.Nil
+	if userId, err := GetUserIDFromContext(ctx); err == nil {
+		viewerId = uuid.FromStringOrNil(userId)
+	}
+	if len(tweets) == 0 {
+		return tweets, nil
+	}
+
+	authors := make([]uuid.UUID, 0, len(tweets))
+	seen := make(map[uuid.UUID]bool, len(tweets))
+	for _, t := range tweets {
+		if t.UserId != viewerId && !seen[t.UserId] {
+			seen[t.UserId] = true
+			authors = append(authors, t.UserId)
+		}
+	}
+	if len(authors) == 0 {
+		return tweets, nil
+	}
+
+	hidden, err := s.Database.GetHiddenAuthorsFromDB(ctx, viewerId, authors)
+	if err != nil {
+		return nil, fmt.Errorf("GetHiddenAuthorsFromDB: %w", err)
+	}
+	if len(hidden) == 0 {
+		return tweets, nil
+	}
+
+	visible := make([]app.Tweet, 0, len(tweets))
+	for _, t := range tweets {
+		if !hidden[t.UserId] {
+			visible = append(visible, t)
+		}
+	}
+	return visible, nil
+}
+
+// visibleTweet читает твит из базы и проверяет, что текущий пользователь может его видеть.
+// Для недоступного твита возвращается NotFound, как и для несуществующего
+func (s GrpcServer) visibleTweet(ctx context.Context, id uuid.UUID) (app.Tweet, error) {
+	tweet, err := s.Database.GetTweetByIDFromDB(ctx, app.Tweet{Id: id})
+	if errors.Is(err, app.ErrTweetNotFound) {
+		return app.Tweet{}, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return app.Tweet{}, fmt.Errorf("GetTweetByIDFromDB: %w", err)
+	}
+	visible, err := s.filterVisible(ctx, []app.Tweet{tweet})
+	if err != nil {
+		return app.Tweet{}, err
+	}
+	if len(visible) == 0 {
+		return app.Tweet{}, status.Error(codes.NotFound, app.ErrTweetNotFound.Error())
+	}
+	return tweet, nil
+}
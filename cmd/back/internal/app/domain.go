@@ -1,15 +1,148 @@
 package app
 
 import (
+	"errors"
 	"time"
 
 	"github.com/gofrs/uuid/v5"
 )
 
+var (
+	ErrTweetNotFound      = errors.New("tweet not found")
+	ErrPollClosed         = errors.New("poll is closed")
+	ErrPollOptionNotFound = errors.New("poll option not found")
+	ErrAlreadyVoted       = errors.New("user already voted in this poll")
+	ErrMediaNotFound      = errors.New("media not found")
+	ErrFolderNotFound     = errors.New("bookmark folder not found")
+	ErrFolderExists       = errors.New("bookmark folder with this name already exists")
+	ErrListNotFound       = errors.New("list not found")
+	ErrListFull           = errors.New("list member limit reached")
+	ErrNotProtected       = errors.New("account is not protected")
+	ErrAccessNotFound     = errors.New("access request not found")
+	ErrProfileNotFound    = errors.New("profile not found")
+	ErrUsernameTaken      = errors.New("username is already taken")
+	ErrVersionConflict    = errors.New("tweet was modified by another request")
+)
+
+// MaxListMembers максимальное число участников одного списка
+const MaxListMembers = 500
+
 type Tweet struct {
 	Id        uuid.UUID
 	Text      string
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	UserId    uuid.UUID
+	// Version растет на единицу при каждом изменении текста
+	Version int64
+	Poll    *Poll
+	Media   []Media
+}
+
+// Poll опрос, прикрепленный к твиту. Идентификатор опроса совпадает с id твита
+type Poll struct {
+	TweetId  uuid.UUID
+	Options  []PollOption
+	ClosesAt time.Time
+}
+
+type PollOption struct {
+	Position int32
+	Text     string
+	Votes    int64
+}
+
+type PollVote struct {
+	TweetId  uuid.UUID
+	UserId   uuid.UUID
+	Position int32
+}
+
+// Closed сообщает, закрыт ли опрос на момент now
+func (p Poll) Closed(now time.Time) bool {
+	return !now.Before(p.ClosesAt)
+}
+
+// Media загруженное пользователем изображение. Файлы лежат в blob-хранилище под ключами,
+// которые строятся из Id
+type Media struct {
+	Id        uuid.UUID
+	UserId    uuid.UUID
+	MimeType  string
+	Size      int64
+	Width     int32
+	Height    int32
+	AltText   string
+	CreatedAt time.Time
+}
+
+// Bookmark закладка пользователя. FolderId пустой для закладок вне папок
+type Bookmark struct {
+	UserId    uuid.UUID
+	TweetId   uuid.UUID
+	FolderId  uuid.NullUUID
+	CreatedAt time.Time
+}
+
+type BookmarkFolder struct {
+	Id        uuid.UUID
+	UserId    uuid.UUID
+	Name      string
+	CreatedAt time.Time
+}
+
+// PageCursor позиция в ленте, отсортированной по (CreatedAt, TweetId) от новых к старым,
+// с которой продолжается выдача
+type PageCursor struct {
+	CreatedAt time.Time
+	TweetId   uuid.UUID
+}
+
+// TweetDeletedEvent публикуется после удаления твита
+type TweetDeletedEvent struct {
+	TweetId uuid.UUID `json:"tweet_id"`
+	UserId  uuid.UUID `json:"user_id"`
+}
+
+// List пользовательский список аккаунтов. Приватный список видит только владелец
+type List struct {
+	Id          uuid.UUID
+	OwnerId     uuid.UUID
+	Name        string
+	Description string
+	Private     bool
+	MemberCount int32
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// VisibleTo сообщает, может ли пользователь видеть список и его ленту
+func (l List) VisibleTo(userId uuid.UUID) bool {
+	return !l.Private || l.OwnerId == userId
+}
+
+const (
+	AccessPending  = "pending"
+	AccessApproved = "approved"
+)
+
+// AccessRequest запрос читателя на доступ к твитам защищенного аккаунта
+type AccessRequest struct {
+	OwnerId   uuid.UUID
+	ReaderId  uuid.UUID
+	Status    string
+	CreatedAt time.Time
+}
+
+// Profile публичный профиль пользователя. Id совпадает с UserId из JWT
+type Profile struct {
+	UserId      uuid.UUID
+	Username    string
+	DisplayName string
+	Bio         string
+	AvatarURL   string
+	Location    string
+	Website     string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
 }
@@ -0,0 +1,17 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var ErrNotFound = errors.New("blob not found")
+
+// Store хранилище бинарных объектов. Ключи имеют вид "dir/name" независимо от бэкенда,
+// поэтому интерфейс ложится и на файловую систему, и на S3-совместимые хранилища
+type Store interface {
+	Put(ctx context.Context, key string, r io.Reader, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
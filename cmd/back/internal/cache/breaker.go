@@ -0,0 +1,63 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"net"
+	"twitter/internal/breaker"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// breakerHook пропускает команды через breaker и ограничивает каждую дедлайном. Пока breaker
+// разомкнут, команды сразу завершаются с breaker.ErrOpen, и вызывающий код идет в базу
+type breakerHook struct {
+	breaker *breaker.Breaker
+}
+
+func (h breakerHook) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return next(ctx, network, addr)
+	}
+}
+
+func (h breakerHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		done, err := h.breaker.Allow()
+		if err != nil {
+			cmd.SetErr(err)
+			return err
+		}
+		ctx, cancel := h.breaker.WithTimeout(ctx)
+		defer cancel()
+		err = next(ctx, cmd)
+		done(err)
+		return err
+	}
+}
+
+func (h breakerHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		done, err := h.breaker.Allow()
+		if err != nil {
+			for _, cmd := range cmds {
+				cmd.SetErr(err)
+			}
+			return err
+		}
+		ctx, cancel := h.breaker.WithTimeout(ctx)
+		defer cancel()
+		err = next(ctx, cmds)
+		done(err)
+		return err
+	}
+}
+
+// IsFailure отличает недоступность Redis от обычных ответов: промаха и ошибок команд
+func IsFailure(err error) bool {
+	if err == nil || err == redis.Nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var replyErr redis.Error
+	return !errors.As(err, &replyErr)
+}
@@ -0,0 +1,197 @@
+package cache
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+	cachepb "twitter/api/proto/cache/v1"
+	"twitter/cmd/back/internal/app"
+
+	"github.com/gofrs/uuid/v5"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrStaleEntry запись сделана несовместимой версией кодека и должна считаться промахом
+var ErrStaleEntry = errors.New("cache entry has unsupported encoding version")
+
+// Значение в кэше: байт версии схемы, байт флагов, версия твита (8 байт big-endian) и тело.
+// Версия твита лежит в заголовке, чтобы SetVersioned сравнивал записи без декодирования
+const (
+	headerSize = 10
+
+	// tweetCodecVersion увеличивается при несовместимом изменении формата или cachepb.Tweet
+	tweetCodecVersion byte = 2
+
+	flagCompressed byte = 1 << 0
+)
+
+// TweetCodec кодирует твиты для кэша в protobuf. Тела не меньше CompressMinSize байт сжимаются,
+// 0 отключает сжатие
+type TweetCodec struct {
+	CompressMinSize int
+}
+
+func (c TweetCodec) Encode(tweet app.Tweet) ([]byte, error) {
+	body, err := proto.Marshal(tweetToCache(tweet))
+	if err != nil {
+		return nil, fmt.Errorf("encode tweet: %w", err)
+	}
+	return frame(tweetCodecVersion, uint64(tweet.Version), body, c.CompressMinSize)
+}
+
+func (c TweetCodec) Decode(data []byte) (app.Tweet, error) {
+	body, err := unframe(tweetCodecVersion, data)
+	if err != nil {
+		return app.Tweet{}, err
+	}
+	var msg cachepb.Tweet
+	if err := proto.Unmarshal(body, &msg); err != nil {
+		return app.Tweet{}, fmt.Errorf("decode tweet: %w", err)
+	}
+	return tweetFromCache(&msg)
+}
+
+func frame(version byte, entityVersion uint64, body []byte, compressMinSize int) ([]byte, error) {
+	var flags byte
+	if compressMinSize > 0 && len(body) >= compressMinSize {
+		var buf bytes.Buffer
+		w, err := flate.NewWriter(&buf, flate.BestSpeed)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(body); err != nil {
+			return nil, fmt.Errorf("compress: %w", err)
+		}
+		if err := w.Close(); err != nil {
+			return nil, fmt.Errorf("compress: %w", err)
+		}
+		// сжатие не помогло: хранить как есть дешевле при чтении
+		if buf.Len() < len(body) {
+			flags |= flagCompressed
+			body = buf.Bytes()
+		}
+	}
+	out := make([]byte, 0, headerSize+len(body))
+	out = append(out, version, flags)
+	out = binary.BigEndian.AppendUint64(out, entityVersion)
+	return append(out, body...), nil
+}
+
+// unframe проверяет версию и возвращает тело. Записи другой версии, в том числе JSON
+// прошлых релизов, дают ErrStaleEntry
+func unframe(version byte, data []byte) ([]byte, error) {
+	if len(data) < headerSize || data[0] != version {
+		return nil, ErrStaleEntry
+	}
+	flags, body := data[1], data[headerSize:]
+	if flags&^flagCompressed != 0 {
+		return nil, ErrStaleEntry
+	}
+	if flags&flagCompressed == 0 {
+		return body, nil
+	}
+	r := flate.NewReader(bytes.NewReader(body))
+	defer r.Close()
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("decompress: %w", err)
+	}
+	return body, nil
+}
+
+func tweetToCache(tweet app.Tweet) *cachepb.Tweet {
+	msg := &cachepb.Tweet{
+		Id:        tweet.Id.Bytes(),
+		Text:      tweet.Text,
+		CreatedAt: timestampToCache(tweet.CreatedAt),
+		UpdatedAt: timestampToCache(tweet.UpdatedAt),
+		UserId:    tweet.UserId.Bytes(),
+		Version:   tweet.Version,
+	}
+	if tweet.Poll != nil {
+		msg.Poll = &cachepb.Poll{ClosesAt: timestampToCache(tweet.Poll.ClosesAt)}
+		for _, o := range tweet.Poll.Options {
+			msg.Poll.Options = append(msg.Poll.Options, &cachepb.PollOption{Position: o.Position, Text: o.Text, Votes: o.Votes})
+		}
+	}
+	for _, m := range tweet.Media {
+		msg.Media = append(msg.Media, &cachepb.Media{
+			Id:        m.Id.Bytes(),
+			UserId:    m.UserId.Bytes(),
+			MimeType:  m.MimeType,
+			Size:      m.Size,
+			Width:     m.Width,
+			Height:    m.Height,
+			AltText:   m.AltText,
+			CreatedAt: timestampToCache(m.CreatedAt),
+		})
+	}
+	return msg
+}
+
+func tweetFromCache(msg *cachepb.Tweet) (app.Tweet, error) {
+	id, err := uuid.FromBytes(msg.Id)
+	if err != nil {
+		return app.Tweet{}, fmt.Errorf("decode tweet id: %w", err)
+	}
+	userId, err := uuid.FromBytes(msg.UserId)
+	if err != nil {
+		return app.Tweet{}, fmt.Errorf("decode tweet user_id: %w", err)
+	}
+	tweet := app.Tweet{
+		Id:        id,
+		Text:      msg.Text,
+		CreatedAt: timestampFromCache(msg.CreatedAt),
+		UpdatedAt: timestampFromCache(msg.UpdatedAt),
+		UserId:    userId,
+		Version:   msg.Version,
+	}
+	if msg.Poll != nil {
+		// id опроса совпадает с id твита и отдельно не хранится
+		tweet.Poll = &app.Poll{TweetId: id, ClosesAt: timestampFromCache(msg.Poll.ClosesAt)}
+		for _, o := range msg.Poll.Options {
+			tweet.Poll.Options = append(tweet.Poll.Options, app.PollOption{Position: o.Position, Text: o.Text, Votes: o.Votes})
+		}
+	}
+	for _, m := range msg.Media {
+		mediaId, err := uuid.FromBytes(m.Id)
+		if err != nil {
+			return app.Tweet{}, fmt.Errorf("decode media id: %w", err)
+		}
+		mediaUserId, err := uuid.FromBytes(m.UserId)
+		if err != nil {
+			return app.Tweet{}, fmt.Errorf("decode media user_id: %w", err)
+		}
+		tweet.Media = append(tweet.Media, app.Media{
+			Id:        mediaId,
+			UserId:    mediaUserId,
+			MimeType:  m.MimeType,
+			Size:      m.Size,
+			Width:     m.Width,
+			Height:    m.Height,
+			AltText:   m.AltText,
+			CreatedAt: timestampFromCache(m.CreatedAt),
+		})
+	}
+	return tweet, nil
+}
+
+// нулевое время не пишется, чтобы после чтения IsZero оставался true
+func timestampToCache(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func timestampFromCache(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
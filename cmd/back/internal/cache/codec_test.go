@@ -0,0 +1,113 @@
+package cache
+
+import (
+	"encoding/binary"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"twitter/cmd/back/internal/app"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func testTweet(text string) app.Tweet {
+	id := uuid.Must(uuid.NewV4())
+	created := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	return app.Tweet{
+		Id:        id,
+		Text:      text,
+		CreatedAt: created,
+		UpdatedAt: created.Add(time.Minute),
+		UserId:    uuid.Must(uuid.NewV4()),
+		Version:   7,
+		Poll: &app.Poll{
+			TweetId:  id,
+			ClosesAt: created.Add(24 * time.Hour),
+			Options: []app.PollOption{
+				{Position: 0, Text: "yes", Votes: 3},
+				{Position: 1, Text: "no", Votes: 1},
+			},
+		},
+		Media: []app.Media{{
+			Id:        uuid.Must(uuid.NewV4()),
+			UserId:    uuid.Must(uuid.NewV4()),
+			MimeType:  "image/png",
+			Size:      1024,
+			Width:     640,
+			Height:    480,
+			AltText:   "cat",
+			CreatedAt: created,
+		}},
+	}
+}
+
+func TestTweetCodecRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		codec TweetCodec
+		tweet app.Tweet
+		// compressed ожидаемый флаг сжатия в заголовке
+		compressed bool
+	}{
+		{name: "plain", codec: TweetCodec{}, tweet: testTweet("hello")},
+		{name: "below compress threshold", codec: TweetCodec{CompressMinSize: 1 << 20}, tweet: testTweet("hello")},
+		{name: "compressed", codec: TweetCodec{CompressMinSize: 64}, tweet: testTweet(strings.Repeat("a", 1000)), compressed: true},
+		{name: "zero times and no poll", codec: TweetCodec{}, tweet: app.Tweet{Id: uuid.Must(uuid.NewV4()), UserId: uuid.Must(uuid.NewV4()), Text: "bare"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.codec.Encode(tt.tweet)
+			if err != nil {
+				t.Fatalf("encode: %v", err)
+			}
+			if data[0] != tweetCodecVersion {
+				t.Errorf("format byte = %d, want %d", data[0], tweetCodecVersion)
+			}
+			if got := data[1]&flagCompressed != 0; got != tt.compressed {
+				t.Errorf("compressed = %v, want %v", got, tt.compressed)
+			}
+			if got := binary.BigEndian.Uint64(data[2:headerSize]); got != uint64(tt.tweet.Version) {
+				t.Errorf("header version = %d, want %d", got, tt.tweet.Version)
+			}
+
+			got, err := tt.codec.Decode(data)
+			if err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.tweet) {
+				t.Errorf("round trip mismatch:\n got %+v\nwant %+v", got, tt.tweet)
+			}
+		})
+	}
+}
+
+func TestTweetCodecRejectsStaleEntries(t *testing.T) {
+	valid, err := TweetCodec{}.Encode(testTweet("hello"))
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	otherVersion := append([]byte{}, valid...)
+	otherVersion[0] = tweetCodecVersion - 1
+	unknownFlags := append([]byte{}, valid...)
+	unknownFlags[1] |= 1 << 7
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty", data: nil},
+		{name: "shorter than header", data: valid[:headerSize-1]},
+		{name: "json from older release", data: []byte(`{"id":"x","text":"hello"}`)},
+		{name: "other codec version", data: otherVersion},
+		{name: "unknown flags", data: unknownFlags},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := (TweetCodec{}).Decode(tt.data); !errors.Is(err, ErrStaleEntry) {
+				t.Errorf("got error %v, want ErrStaleEntry", err)
+			}
+		})
+	}
+}
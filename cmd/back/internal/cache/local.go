@@ -0,0 +1,105 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// LocalCache ограниченный по числу записей LRU-кэш в памяти процесса. У каждой записи свое
+// время жизни, но не больше ttl кэша: данные могут поменять другие реплики.
+// Нулевой *LocalCache ничего не хранит
+type LocalCache struct {
+	mu    sync.Mutex
+	size  int
+	ttl   time.Duration
+	items map[string]*list.Element
+	// order записи от недавно использованных к давно использованным
+	order *list.List
+}
+
+type localEntry struct {
+	key     string
+	value   string
+	expires time.Time
+}
+
+// NewLocalCache создает кэш на size записей. При size 0 кэш в памяти отключен и возвращается nil
+func NewLocalCache(size int, ttl time.Duration) *LocalCache {
+	if size <= 0 {
+		return nil
+	}
+	return &LocalCache{
+		size:  size,
+		ttl:   ttl,
+		items: make(map[string]*list.Element, size),
+		order: list.New(),
+	}
+}
+
+// Get возвращает значение, если оно есть и не истекло
+func (c *LocalCache) Get(key string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return "", false
+	}
+	entry := el.Value.(*localEntry)
+	if time.Now().After(entry.expires) {
+		c.remove(el)
+		return "", false
+	}
+	c.order.MoveToFront(el)
+	return entry.value, true
+}
+
+// Set записывает значение на ttl, но не дольше времени жизни кэша. При переполнении
+// вытесняется давно не использованная запись
+func (c *LocalCache) Set(key string, value string, ttl time.Duration) {
+	if c == nil {
+		return
+	}
+	if ttl <= 0 || ttl > c.ttl {
+		ttl = c.ttl
+	}
+	expires := time.Now().Add(ttl)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		entry := el.Value.(*localEntry)
+		entry.value = value
+		entry.expires = expires
+		c.order.MoveToFront(el)
+		return
+	}
+	c.items[key] = c.order.PushFront(&localEntry{key: key, value: value, expires: expires})
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+}
+
+func (c *LocalCache) Delete(keys ...string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		if el, ok := c.items[key]; ok {
+			c.remove(el)
+		}
+	}
+}
+
+func (c *LocalCache) remove(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.items, el.Value.(*localEntry).key)
+}
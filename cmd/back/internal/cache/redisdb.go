@@ -2,31 +2,114 @@ package cache
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
 	"time"
+	"twitter/internal/breaker"
 
+	"github.com/redis/go-redis/extra/redisotel/v9"
 	"github.com/redis/go-redis/v9"
 )
 
+// ErrMiss ключа нет в кэше. Остальные ошибки означают недоступность Redis
+var ErrMiss = errors.New("cache miss")
+
+// Топологии Redis
+const (
+	TopologyStandalone = "standalone"
+	TopologySentinel   = "sentinel"
+	TopologyCluster    = "cluster"
+)
+
+// Options настройки подключения. Нулевые размеры пула и таймауты означают значения go-redis
+// по умолчанию
+type Options struct {
+	Topology string
+	// Addrs адрес сервера, адреса Sentinel или начальные узлы кластера
+	Addrs []string
+	// MasterName имя мастера в Sentinel
+	MasterName string
+	Username   string
+	Password   string
+	// KeyPrefix добавляется ко всем ключам и каналам, чтобы несколько сервисов делили один
+	// Redis без номеров баз, которых нет в Cluster
+	KeyPrefix    string
+	PoolSize     int
+	MinIdleConns int
+	DialTimeout  time.Duration
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+	PoolTimeout  time.Duration
+	// Breaker защищает от недоступного Redis и задает дедлайн команд. nil - без защиты
+	Breaker *breaker.Breaker
+}
+
 type RedisClient struct {
-	client *redis.Client
-	// ctx    context.Context
+	client  redis.UniversalClient
+	prefix  string
+	cluster bool
 }
 
-func NewRedisClient(addr, password string, db int) *RedisClient {
+func NewRedisClient(opts Options) (*RedisClient, error) {
+	universal := &redis.UniversalOptions{
+		Addrs:        opts.Addrs,
+		MasterName:   opts.MasterName,
+		Username:     opts.Username,
+		Password:     opts.Password,
+		PoolSize:     opts.PoolSize,
+		MinIdleConns: opts.MinIdleConns,
+		DialTimeout:  opts.DialTimeout,
+		ReadTimeout:  opts.ReadTimeout,
+		WriteTimeout: opts.WriteTimeout,
+		PoolTimeout:  opts.PoolTimeout,
+		// дедлайн контекста запроса ограничивает и сетевые операции
+		ContextTimeoutEnabled: true,
+	}
+	// тип клиента задается явно: redis.NewUniversalClient по одному адресу без MasterName
+	// создал бы обычный клиент даже для кластера
+	var client redis.UniversalClient
+	switch opts.Topology {
+	case TopologyStandalone, "":
+		if len(opts.Addrs) != 1 {
+			return nil, fmt.Errorf("redis %s: exactly one address required, got %d", TopologyStandalone, len(opts.Addrs))
+		}
+		client = redis.NewClient(universal.Simple())
+	case TopologySentinel:
+		if opts.MasterName == "" {
+			return nil, fmt.Errorf("redis %s: master name required", TopologySentinel)
+		}
+		client = redis.NewFailoverClient(universal.Failover())
+	case TopologyCluster:
+		client = redis.NewClusterClient(universal.Cluster())
+	default:
+		return nil, fmt.Errorf("unknown redis topology %q", opts.Topology)
+	}
+	// спан на каждую команду и пайплайн
+	if err := redisotel.InstrumentTracing(client); err != nil {
+		return nil, fmt.Errorf("redis tracing: %w", err)
+	}
+	// после трассировки, чтобы отклоненные команды тоже попадали в спаны
+	if opts.Breaker != nil {
+		client.AddHook(breakerHook{breaker: opts.Breaker})
+	}
 	return &RedisClient{
-		client: redis.NewClient(&redis.Options{
-			Addr:     addr,
-			Password: password,
-			DB:       db,
-			// DisableIdentity: true, // ← ПРАВИЛЬНОЕ написание
-			// PoolSize:     10,
-			// MinIdleConns: 5,
-			// DialTimeout:  5 * time.Second,
-			// ReadTimeout:  3 * time.Second,
-			// WriteTimeout: 3 * time.Second,
-		}),
-		// ctx: context.Background(),
+		client:  client,
+		prefix:  opts.KeyPrefix,
+		cluster: opts.Topology == TopologyCluster,
+	}, nil
+}
+
+func (r *RedisClient) key(key string) string {
+	return r.prefix + key
+}
+
+func (r *RedisClient) keys(keys []string) []string {
+	prefixed := make([]string, len(keys))
+	for i, key := range keys {
+		prefixed[i] = r.key(key)
 	}
+	return prefixed
 }
 
 func (r *RedisClient) Connect(ctx context.Context) error {
@@ -34,33 +117,327 @@ func (r *RedisClient) Connect(ctx context.Context) error {
 	return err
 }
 
+// Ping проверяет доступность Redis для readiness
+func (r *RedisClient) Ping(ctx context.Context) error {
+	return r.client.Ping(ctx).Err()
+}
+
 func (r *RedisClient) Close() error {
 	return r.client.Close()
 }
 
 func (r *RedisClient) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
-	return r.client.Set(ctx, key, value, expiration).Err()
+	return r.client.Set(ctx, r.key(key), value, expiration).Err()
 }
 
+// Get читает ключ. Для отсутствующего ключа возвращается ErrMiss
 func (r *RedisClient) Get(ctx context.Context, key string) (string, error) {
-	return r.client.Get(ctx, key).Result()
+	return missOrResult(r.client.Get(ctx, r.key(key)).Result())
 }
 
+// GetDelete читает и удаляет ключ. Для отсутствующего ключа возвращается ErrMiss
 func (r *RedisClient) GetDelete(ctx context.Context, key string) (string, error) {
-	return r.client.GetDel(ctx, key).Result()
+	return missOrResult(r.client.GetDel(ctx, r.key(key)).Result())
+}
+
+func missOrResult(value string, err error) (string, error) {
+	if err == redis.Nil {
+		return "", ErrMiss
+	}
+	return value, err
+}
+
+// SetIfNotExists записывает ключ, только если его еще нет. Возвращает true, если запись прошла
+func (r *RedisClient) SetIfNotExists(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+	return r.client.SetNX(ctx, r.key(key), value, expiration).Result()
+}
+
+// Delete удаляет ключи
+func (r *RedisClient) Delete(ctx context.Context, keys ...string) error {
+	if r.cluster && len(keys) > 1 {
+		// ключи из разных слотов одной командой в кластере не удалить
+		_, err := r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			for _, key := range keys {
+				pipe.Del(ctx, r.key(key))
+			}
+			return nil
+		})
+		return err
+	}
+	return r.client.Del(ctx, r.keys(keys)...).Err()
+}
+
+// GetMany читает несколько ключей одним MGET. Отсутствующие ключи в ответ не попадают
+func (r *RedisClient) GetMany(ctx context.Context, keys ...string) (map[string]string, error) {
+	if r.cluster {
+		return r.getManyPipelined(ctx, keys)
+	}
+	values, err := r.client.MGet(ctx, r.keys(keys)...).Result()
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[string]string, len(values))
+	for i, v := range values {
+		if str, ok := v.(string); ok {
+			found[keys[i]] = str
+		}
+	}
+	return found, nil
+}
+
+// getManyPipelined заменяет MGET в кластере: go-redis раскладывает пайплайн по узлам
+func (r *RedisClient) getManyPipelined(ctx context.Context, keys []string) (map[string]string, error) {
+	cmds := make([]*redis.StringCmd, len(keys))
+	// ошибка пайплайна - первая из ошибок команд, ее redis.Nil ничего не говорит об остальных
+	_, _ = r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, key := range keys {
+			cmds[i] = pipe.Get(ctx, r.key(key))
+		}
+		return nil
+	})
+	found := make(map[string]string, len(keys))
+	for i, cmd := range cmds {
+		value, err := cmd.Result()
+		switch {
+		case err == nil:
+			found[keys[i]] = value
+		case err != redis.Nil:
+			return nil, err
+		}
+	}
+	return found, nil
+}
+
+// SetMany записывает несколько ключей одним пайплайном. MSET не умеет задавать время жизни,
+// поэтому используется SET на каждый ключ
+func (r *RedisClient) SetMany(ctx context.Context, values map[string]interface{}, expiration time.Duration) error {
+	_, err := r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for key, value := range values {
+			pipe.Set(ctx, r.key(key), value, expiration)
+		}
+		return nil
+	})
+	return err
+}
+
+// setVersioned записывает значение формата TweetCodec, только если в кэше нет записи того же
+// формата с такой же или большей версией. Значения короче заголовка - отметки, например
+// об отсутствии твита, и тоже не перезаписываются. Записи других форматов заменяются.
+// ARGV[2] - время жизни в миллисекундах, 0 - без ограничения
+var setVersioned = redis.NewScript(`
+local headerSize = tonumber(ARGV[3])
+-- версия из байтов 3..headerSize, big-endian. Сравнение строк в Lua зависит от локали
+local function version(value)
+	local v = 0
+	for i = 3, headerSize do
+		v = v * 256 + string.byte(value, i)
+	end
+	return v
+end
+local current = redis.call("GET", KEYS[1])
+if current then
+	if string.len(current) < headerSize then
+		return 0
+	end
+	if string.byte(current, 1) == string.byte(ARGV[1], 1) and version(current) >= version(ARGV[1]) then
+		return 0
+	end
+end
+if tonumber(ARGV[2]) > 0 then
+	redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+else
+	redis.call("SET", KEYS[1], ARGV[1])
+end
+return 1
+`)
+
+// SetVersioned записывает значение TweetCodec, если оно новее записанного. Так чтение из базы,
+// начатое до изменения, не затрет в кэше более новую версию. Возвращает true, если запись прошла
+func (r *RedisClient) SetVersioned(ctx context.Context, key string, value []byte, expiration time.Duration) (bool, error) {
+	return setVersioned.Run(ctx, r.client, []string{r.key(key)}, value, expiration.Milliseconds(), headerSize).Bool()
 }
 
-// AddToRight добавляет элемент в конец списка
-func (r *RedisClient) AddToRight(ctx context.Context, key string, items ...string) error {
-	return r.client.RPush(ctx, key, items).Err()
+// SetManyVersioned то же для нескольких ключей одним пайплайном. Возвращает ключи, запись которых прошла
+func (r *RedisClient) SetManyVersioned(ctx context.Context, values map[string][]byte, expiration time.Duration) ([]string, error) {
+	keys := make([]string, 0, len(values))
+	cmds := make([]*redis.Cmd, 0, len(values))
+	// в пайплайне EVALSHA не повторить через EVAL после NOSCRIPT, поэтому скрипт передается целиком
+	_, err := r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for key, value := range values {
+			keys = append(keys, key)
+			cmds = append(cmds, setVersioned.Eval(ctx, pipe, []string{r.key(key)}, value, expiration.Milliseconds(), headerSize))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	applied := make([]string, 0, len(keys))
+	for i, cmd := range cmds {
+		if ok, _ := cmd.Bool(); ok {
+			applied = append(applied, keys[i])
+		}
+	}
+	return applied, nil
+}
+
+// Publish отправляет сообщение в канал pub/sub
+func (r *RedisClient) Publish(ctx context.Context, channel string, message interface{}) error {
+	return r.client.Publish(ctx, r.key(channel), message).Err()
+}
+
+// Subscribe вызывает handler на каждое сообщение канала до отмены ctx. После обрыва
+// соединения подписка восстанавливается, но сообщения за время обрыва теряются
+func (r *RedisClient) Subscribe(ctx context.Context, channel string, handler func(payload string)) error {
+	sub := r.client.Subscribe(ctx, r.key(channel))
+	defer sub.Close()
+	if _, err := sub.Receive(ctx); err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return err
+	}
+	messages := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-messages:
+			if !ok {
+				return nil
+			}
+			handler(msg.Payload)
+		}
+	}
 }
 
-// GetList получает весь список
-func (r *RedisClient) GetList(ctx context.Context, key string) ([]string, error) {
-	return r.client.LRange(ctx, key, 0, -1).Result()
+// SortedMember элемент отсортированного множества
+type SortedMember struct {
+	Member string
+	Score  float64
 }
 
-// RemoveElements удаляет все элементы по значению. 0-все
-func (r *RedisClient) RemoveElements(ctx context.Context, key string, value string) (int64, error) {
-	return r.client.LRem(ctx, key, 0, value).Result()
+// sortedSentinel служебный элемент с наименьшим счетом, который есть в каждом множестве
+// в кэше. Благодаря ему пустое множество хранится в Redis и отличается от промаха
+const sortedSentinel = ""
+
+// ReplaceSorted перезаписывает отсортированное множество целиком и задает ему время жизни.
+// Пустое множество тоже записывается
+func (r *RedisClient) ReplaceSorted(ctx context.Context, key string, members []SortedMember, expiration time.Duration) error {
+	z := make([]redis.Z, 0, len(members)+1)
+	z = append(z, redis.Z{Score: math.Inf(-1), Member: sortedSentinel})
+	for _, m := range members {
+		z = append(z, redis.Z{Score: m.Score, Member: m.Member})
+	}
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, r.key(key))
+		pipe.ZAdd(ctx, r.key(key), z...)
+		pipe.Expire(ctx, r.key(key), expiration)
+		return nil
+	})
+	return err
+}
+
+// addSortedIfExists добавляет элемент, только если множество уже есть в кэше, чтобы не создавать
+// неполное множество, и оставляет ARGV[3] элементов с наибольшим счетом. Служебный элемент
+// с рангом 0 не удаляется
+var addSortedIfExists = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return 0
+end
+redis.call("ZADD", KEYS[1], ARGV[1], ARGV[2])
+local maxLen = tonumber(ARGV[3])
+if maxLen > 0 then
+	redis.call("ZREMRANGEBYRANK", KEYS[1], 1, -maxLen - 1)
+end
+return 1
+`)
+
+// AddSortedIfExists добавляет элемент в существующее множество и обрезает его до maxLen элементов.
+// maxLen 0 - без ограничения
+func (r *RedisClient) AddSortedIfExists(ctx context.Context, key string, member SortedMember, maxLen int64) error {
+	return addSortedIfExists.Run(ctx, r.client, []string{r.key(key)}, member.Score, member.Member, maxLen).Err()
+}
+
+// RangeSortedDesc возвращает до limit элементов по убыванию счета. Для множества, которого
+// нет в кэше, возвращается ErrMiss, для записанного пустого - пустой список
+func (r *RedisClient) RangeSortedDesc(ctx context.Context, key string, limit int64) ([]string, error) {
+	members, err := r.client.ZRevRange(ctx, r.key(key), 0, limit).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(members) == 0 {
+		return nil, ErrMiss
+	}
+	if members[len(members)-1] == sortedSentinel {
+		members = members[:len(members)-1]
+	}
+	if int64(len(members)) > limit {
+		members = members[:limit]
+	}
+	return members, nil
+}
+
+// RemoveSorted удаляет элементы из множества
+func (r *RedisClient) RemoveSorted(ctx context.Context, key string, members ...string) error {
+	values := make([]interface{}, len(members))
+	for i, m := range members {
+		values[i] = m
+	}
+	return r.client.ZRem(ctx, r.key(key), values...).Err()
+}
+
+// incrementIfExists увеличивает поле хэша, только если сам хэш уже есть в кэше,
+// чтобы не создавать неполный набор счетчиков
+var incrementIfExists = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return redis.call("HINCRBY", KEYS[1], ARGV[1], ARGV[2])
+end
+return nil
+`)
+
+// IncrementField увеличивает счетчик в хэше. Отсутствующий хэш не создается
+func (r *RedisClient) IncrementField(ctx context.Context, key string, field string, incr int64) error {
+	err := incrementIfExists.Run(ctx, r.client, []string{r.key(key)}, field, incr).Err()
+	if err == redis.Nil {
+		return nil
+	}
+	return err
+}
+
+// GetFields получает все поля хэша. Для отсутствующего ключа возвращается пустая map
+func (r *RedisClient) GetFields(ctx context.Context, key string) (map[string]string, error) {
+	return r.client.HGetAll(ctx, r.key(key)).Result()
+}
+
+// SetFields перезаписывает хэш целиком и задает ему время жизни
+func (r *RedisClient) SetFields(ctx context.Context, key string, values map[string]interface{}, expiration time.Duration) error {
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, r.key(key))
+		pipe.HSet(ctx, r.key(key), values)
+		pipe.Expire(ctx, r.key(key), expiration)
+		return nil
+	})
+	return err
+}
+
+// incrementWindow увеличивает счетчик окна и при первом обращении задает окну время жизни.
+// Возвращает значение счетчика и оставшееся время окна в миллисекундах
+var incrementWindow = redis.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+local ttl = redis.call("PTTL", KEYS[1])
+if ttl < 0 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+	ttl = tonumber(ARGV[1])
+end
+return {count, ttl}
+`)
+
+// IncrementWindow увеличивает счетчик фиксированного окна window
+func (r *RedisClient) IncrementWindow(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error) {
+	res, err := incrementWindow.Run(ctx, r.client, []string{r.key(key)}, window.Milliseconds()).Int64Slice()
+	if err != nil {
+		return 0, 0, err
+	}
+	return res[0], time.Duration(res[1]) * time.Millisecond, nil
 }
@@ -0,0 +1,246 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+	"twitter/internal/logger"
+	"twitter/internal/metrics"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// Слои кэша в метриках
+const (
+	layerLocal = "local"
+	layerRedis = "redis"
+)
+
+// Пауза между попытками подписаться на канал изменений растет вдвое от minResubscribeDelay
+// до maxResubscribeDelay
+const (
+	minResubscribeDelay = 100 * time.Millisecond
+	maxResubscribeDelay = 30 * time.Second
+)
+
+// TieredCache кэш в памяти процесса перед Redis. Запись и удаление идут в оба слоя,
+// а остальные реплики узнают об изменении из канала pub/sub и удаляют ключ у себя.
+// С nil local кэш работает только с Redis, но изменения по-прежнему публикует
+type TieredCache struct {
+	name    string
+	local   *LocalCache
+	remote  *RedisClient
+	channel string
+	// origin отличает собственные сообщения об изменениях от сообщений других реплик
+	origin string
+}
+
+// invalidation сообщение об измененных ключах
+type invalidation struct {
+	Origin string   `json:"origin"`
+	Keys   []string `json:"keys"`
+}
+
+// NewTieredCache создает кэш с именем name для метрик. Изменения публикуются в channel
+func NewTieredCache(name string, local *LocalCache, remote *RedisClient, channel string) *TieredCache {
+	return &TieredCache{
+		name:    name,
+		local:   local,
+		remote:  remote,
+		channel: channel,
+		origin:  uuid.Must(uuid.NewV4()).String(),
+	}
+}
+
+func (c *TieredCache) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	// при ошибке Redis локальная копия тоже не должна пережить изменение
+	c.local.Delete(key)
+	if err := c.remote.Set(ctx, key, value, expiration); err != nil {
+		return err
+	}
+	if str, ok := stringValue(value); ok {
+		c.local.Set(key, str, expiration)
+	}
+	c.publish(ctx, key)
+	return nil
+}
+
+// SetVersioned записывает значение, если оно новее записанного в Redis. В память значение
+// попадает, только если запись прошла: иначе в Redis лежит более новая версия
+func (c *TieredCache) SetVersioned(ctx context.Context, key string, value []byte, expiration time.Duration) error {
+	c.local.Delete(key)
+	applied, err := c.remote.SetVersioned(ctx, key, value, expiration)
+	if err != nil || !applied {
+		return err
+	}
+	c.local.Set(key, string(value), expiration)
+	c.publish(ctx, key)
+	return nil
+}
+
+func (c *TieredCache) SetManyVersioned(ctx context.Context, values map[string][]byte, expiration time.Duration) error {
+	for key := range values {
+		c.local.Delete(key)
+	}
+	applied, err := c.remote.SetManyVersioned(ctx, values, expiration)
+	if err != nil || len(applied) == 0 {
+		return err
+	}
+	for _, key := range applied {
+		c.local.Set(key, string(values[key]), expiration)
+	}
+	c.publish(ctx, applied...)
+	return nil
+}
+
+func (c *TieredCache) Get(ctx context.Context, key string) (string, error) {
+	if value, ok := c.local.Get(key); ok {
+		c.observe(layerLocal, "hit", 1)
+		return value, nil
+	}
+	c.observe(layerLocal, "miss", 1)
+
+	value, err := c.remote.Get(ctx, key)
+	switch {
+	case err == nil:
+		c.observe(layerRedis, "hit", 1)
+		c.local.Set(key, value, 0)
+	case errors.Is(err, ErrMiss):
+		c.observe(layerRedis, "miss", 1)
+	default:
+		c.observe(layerRedis, "error", 1)
+	}
+	return value, err
+}
+
+func (c *TieredCache) Delete(ctx context.Context, keys ...string) error {
+	c.local.Delete(keys...)
+	if err := c.remote.Delete(ctx, keys...); err != nil {
+		return err
+	}
+	c.publish(ctx, keys...)
+	return nil
+}
+
+// GetMany читает из памяти то, что есть, а остальное одним MGET из Redis
+func (c *TieredCache) GetMany(ctx context.Context, keys ...string) (map[string]string, error) {
+	found := make(map[string]string, len(keys))
+	var rest []string
+	for _, key := range keys {
+		if value, ok := c.local.Get(key); ok {
+			found[key] = value
+			continue
+		}
+		rest = append(rest, key)
+	}
+	c.observe(layerLocal, "hit", len(found))
+	c.observe(layerLocal, "miss", len(rest))
+	if len(rest) == 0 {
+		return found, nil
+	}
+
+	fromRemote, err := c.remote.GetMany(ctx, rest...)
+	if err != nil {
+		c.observe(layerRedis, "error", len(rest))
+		return found, err
+	}
+	c.observe(layerRedis, "hit", len(fromRemote))
+	c.observe(layerRedis, "miss", len(rest)-len(fromRemote))
+	for key, value := range fromRemote {
+		found[key] = value
+		c.local.Set(key, value, 0)
+	}
+	return found, nil
+}
+
+func (c *TieredCache) SetMany(ctx context.Context, values map[string]interface{}, expiration time.Duration) error {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	c.local.Delete(keys...)
+	if err := c.remote.SetMany(ctx, values, expiration); err != nil {
+		return err
+	}
+	for key, value := range values {
+		if str, ok := stringValue(value); ok {
+			c.local.Set(key, str, expiration)
+		}
+	}
+	c.publish(ctx, keys...)
+	return nil
+}
+
+// ListenInvalidations удаляет из памяти ключи, измененные другими репликами, до отмены ctx.
+// Если подписаться не удалось, попытки повторяются с растущей паузой: пока подписки нет,
+// копии в памяти устаревают не дольше чем на их время жизни
+func (c *TieredCache) ListenInvalidations(ctx context.Context) error {
+	if c.local == nil {
+		return nil
+	}
+	log := logger.FromContext(ctx)
+	handler := func(payload string) {
+		var msg invalidation
+		if err := json.Unmarshal([]byte(payload), &msg); err != nil {
+			log.WarnContext(ctx, "cache invalidation: invalid message", "cache", c.name, "error", err)
+			return
+		}
+		if msg.Origin != c.origin {
+			c.local.Delete(msg.Keys...)
+		}
+	}
+
+	delay := minResubscribeDelay
+	for {
+		err := c.remote.Subscribe(ctx, c.channel, handler)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err == nil {
+			// подписка работала и закрылась: следующая попытка сразу с минимальной паузой
+			delay = minResubscribeDelay
+		}
+		log.WarnContext(ctx, "cache invalidation subscribe failed, retrying", "cache", c.name, "retry_in", delay, "error", err)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxResubscribeDelay)
+	}
+}
+
+// publish сообщает другим репликам об изменении. Если сообщение не дошло, их копии
+// устареют не дольше чем на время жизни локального кэша
+func (c *TieredCache) publish(ctx context.Context, keys ...string) {
+	payload, err := json.Marshal(invalidation{Origin: c.origin, Keys: keys})
+	if err == nil {
+		err = c.remote.Publish(ctx, c.channel, payload)
+	}
+	if err != nil {
+		logger.FromContext(ctx).WarnContext(ctx, "cache invalidation publish failed", "cache", c.name, "error", err)
+	}
+}
+
+func (c *TieredCache) observe(layer string, result string, n int) {
+	if layer == layerLocal && c.local == nil {
+		return
+	}
+	if n > 0 {
+		metrics.CacheLookupsTotal.WithLabelValues(c.name, layer, result).Add(float64(n))
+	}
+}
+
+func stringValue(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case []byte:
+		return string(v), true
+	case fmt.Stringer:
+		return v.String(), true
+	}
+	return "", false
+}
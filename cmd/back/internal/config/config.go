@@ -0,0 +1,527 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// EnvPrefix префикс переменных окружения. Имя переменной - префикс и yaml-ключ поля
+// в верхнем регистре, например TWITTER_DSN или TWITTER_RATE_LIMITS
+const EnvPrefix = "TWITTER_"
+
+// Значения по умолчанию. Это единственное место, где они задаются: пакеты, которые читает main,
+// используют переданные значения как есть, а конфиг от них не зависит
+const (
+	// DefaultCacheKeyPrefix пространство имен ключей сервиса в Redis
+	DefaultCacheKeyPrefix          = "twitter:"
+	DefaultIdempotencyTTL          = 24 * time.Hour
+	DefaultBreakerFailureThreshold = 5
+	DefaultBreakerOpenTimeout      = 10 * time.Second
+	DefaultQueueSizeRBMQ           = 1000
+	DefaultConcurrencyInitialLimit = 100
+	DefaultConcurrencyMinLimit     = 10
+	DefaultConcurrencyMaxLimit     = 1000
+	DefaultConcurrencyLatency      = time.Second
+	DefaultLocalCacheSize          = 10000
+	DefaultLocalCacheTTL           = 5 * time.Second
+	DefaultTweetCacheTTL           = 10 * time.Minute
+	DefaultTweetNotFoundTTL        = 30 * time.Second
+	DefaultPollVotesTTL            = time.Minute
+	DefaultClosedPollVotesTTL      = 10 * time.Minute
+	DefaultUserTimelineMaxLen      = 500
+	DefaultUserTimelineTTL         = 10 * time.Minute
+)
+
+// Допустимые значения перечислимых полей
+const (
+	LogFormatText = "text"
+	LogFormatJSON = "json"
+
+	CacheTopologyStandalone = "standalone"
+	CacheTopologySentinel   = "sentinel"
+	CacheTopologyCluster    = "cluster"
+
+	FallbackCacheSkip = "skip"
+	FallbackCacheFail = "fail"
+
+	FallbackRBMQQueue = "queue"
+	FallbackRBMQDrop  = "drop"
+
+	TraceExporterNone   = "none"
+	TraceExporterOTLP   = "otlp"
+	TraceExporterStdout = "stdout"
+	TraceExporterFile   = "file"
+)
+
+// redactedValue подставляется вместо секретов в config print --redact
+const redactedValue = "***"
+
+type Config struct {
+	DSN               string        `yaml:"dsn"`
+	Host              string        `yaml:"host"`
+	HostGRPC          string        `yaml:"host_grpc"`
+	MigrateDir        string        `yaml:"migrate_dir"`
+	Driver            string        `yaml:"driver"`
+	LogLevel          Level         `yaml:"loglevel"`
+	LogFormat         string        `yaml:"log_format"`
+	TimeOut           time.Duration `yaml:"timeout"`
+	JwtSecret         string        `yaml:"jwt_secret"`
+	JwtSecretFile     string        `yaml:"jwt_secret_file"`
+	AddrCache         string        `yaml:"addr_cache"`
+	UserNameCache     string        `yaml:"username_cache"`
+	PasswordCache     string        `yaml:"password_cache"`
+	PasswordCacheFile string        `yaml:"password_cache_file"`
+	HostRBMQ          string        `yaml:"host_rbmq"`
+	PortRBMQ          string        `yaml:"port_rbmq"`
+	UserNameRBMQ      string        `yaml:"username_rbmq"`
+	PasswordRBMQ      string        `yaml:"password_rbmq"`
+	PasswordRBMQFile  string        `yaml:"password_rbmq_file"`
+	VHostRBMQ         string        `yaml:"vhost_rbmq"`
+	MediaDir          string        `yaml:"media_dir"`
+	IdempotencyTTL    time.Duration `yaml:"idempotency_ttl"`
+	// CacheTopology standalone, sentinel или cluster. Для sentinel и cluster в addr_cache
+	// перечисляются адреса через запятую
+	CacheTopology string `yaml:"cache_topology"`
+	// CacheMasterName имя мастера для cache_topology: sentinel
+	CacheMasterName string `yaml:"cache_master_name"`
+	// CacheKeyPrefix пространство имен сервиса в Redis вместо номеров баз
+	CacheKeyPrefix    string        `yaml:"cache_key_prefix"`
+	CachePoolSize     int           `yaml:"cache_pool_size"`
+	CacheMinIdleConns int           `yaml:"cache_min_idle_conns"`
+	CacheDialTimeout  time.Duration `yaml:"cache_dial_timeout"`
+	CacheReadTimeout  time.Duration `yaml:"cache_read_timeout"`
+	CacheWriteTimeout time.Duration `yaml:"cache_write_timeout"`
+	CachePoolTimeout  time.Duration `yaml:"cache_pool_timeout"`
+	// TimeOutCache, TimeOutDB и TimeOutRBMQ дедлайны вызовов Redis, Postgres и RabbitMQ,
+	// по умолчанию timeout. Отсчитываются от контекста запроса
+	TimeOutCache time.Duration `yaml:"timeout_cache"`
+	TimeOutDB    time.Duration `yaml:"timeout_db"`
+	TimeOutRBMQ  time.Duration `yaml:"timeout_rbmq"`
+	// BreakerFailureThreshold сколько отказов зависимости подряд размыкает ее circuit breaker
+	BreakerFailureThreshold int `yaml:"breaker_failure_threshold"`
+	// BreakerOpenTimeout сколько breaker остается разомкнутым до пробного вызова
+	BreakerOpenTimeout time.Duration `yaml:"breaker_open_timeout"`
+	// FallbackCache при разомкнутом breaker Redis: skip - читать из базы, fail - отвечать Unavailable
+	FallbackCache string `yaml:"fallback_cache"`
+	// FallbackRBMQ при недоступном брокере: queue - отложить событие, drop - вернуть ошибку
+	FallbackRBMQ string `yaml:"fallback_rbmq"`
+	// QueueSizeRBMQ сколько событий откладывается в памяти при fallback_rbmq: queue
+	QueueSizeRBMQ int `yaml:"queue_size_rbmq"`
+	// Адаптивный лимит одновременных запросов, отдельный для gRPC и gateway. Запрос дольше
+	// concurrency_latency_threshold уменьшает лимит
+	ConcurrencyInitialLimit     int           `yaml:"concurrency_initial_limit"`
+	ConcurrencyMinLimit         int           `yaml:"concurrency_min_limit"`
+	ConcurrencyMaxLimit         int           `yaml:"concurrency_max_limit"`
+	ConcurrencyLatencyThreshold time.Duration `yaml:"concurrency_latency_threshold"`
+	// LocalCacheSize сколько твитов хранится в памяти процесса перед Redis, 0 отключает кэш
+	// в памяти. Указатель отличает явный 0 от отсутствующего ключа
+	LocalCacheSize *int `yaml:"local_cache_size"`
+	// LocalCacheTTL время жизни твита в памяти. Ограничивает устаревание копии, если сообщение
+	// об изменении от другой реплики потерялось
+	LocalCacheTTL time.Duration `yaml:"local_cache_ttl"`
+	// CacheCompressMinSize с какого размера в байтах твит в кэше сжимается, 0 отключает сжатие
+	CacheCompressMinSize int `yaml:"cache_compress_min_size"`
+	// RateLimits лимиты по имени метода gRPC, например CreateTweet, и правило default для остальных
+	RateLimits    map[string]RateLimit `yaml:"rate_limits"`
+	HTTPRateLimit RateLimit            `yaml:"http_rate_limit"`
+	// PublicMethods методы gRPC, доступные без токена, в дополнение к проверкам здоровья
+	PublicMethods []string `yaml:"public_methods"`
+	// Настройки ниже применяются по SIGHUP без перезапуска, как и loglevel и jwt_secret
+	TweetCacheTTL      time.Duration `yaml:"tweet_cache_ttl"`
+	TweetNotFoundTTL   time.Duration `yaml:"tweet_not_found_ttl"`
+	PollVotesTTL       time.Duration `yaml:"poll_votes_ttl"`
+	ClosedPollVotesTTL time.Duration `yaml:"closed_poll_votes_ttl"`
+	// UserTimelineMaxLen сколько последних твитов пользователя хранится в кэше. Более старые
+	// страницы GetUserTweets читаются из базы
+	UserTimelineMaxLen int `yaml:"user_timeline_max_len"`
+	// UserTimelineTTL время жизни ленты пользователя в кэше
+	UserTimelineTTL time.Duration `yaml:"user_timeline_ttl"`
+	// AdminUserIds пользователи, которым доступны административные методы
+	AdminUserIds []string `yaml:"admin_user_ids"`
+	// TraceExporter куда отправлять спаны: none, otlp, stdout или file
+	TraceExporter string `yaml:"trace_exporter"`
+	// TraceEndpoint адрес OTLP/gRPC коллектора для trace_exporter: otlp
+	TraceEndpoint string `yaml:"trace_endpoint"`
+	// TraceFile файл для trace_exporter: file
+	TraceFile        string  `yaml:"trace_file"`
+	TraceSampleRatio float64 `yaml:"trace_sample_ratio"`
+}
+
+// Load читает YAML-файл, применяет переменные окружения TWITTER_*, читает секреты из файлов,
+// подставляет значения по умолчанию и проверяет результат
+func Load(path string) (Config, error) {
+	var cfg Config
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("read config: %w", err)
+	}
+	if err := yaml.Unmarshal(raw, &cfg); err != nil {
+		return Config{}, fmt.Errorf("parse config %s: %w", path, err)
+	}
+	if err := cfg.applyEnv(os.LookupEnv); err != nil {
+		return Config{}, err
+	}
+	if err := cfg.readSecretFiles(); err != nil {
+		return Config{}, err
+	}
+	cfg.setDefaults()
+	if err := cfg.Validate(); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+// applyEnv переопределяет поля из окружения. Строки, числа и длительности задаются как есть,
+// составные поля (rate_limits, public_methods) - в YAML или JSON
+func (c *Config) applyEnv(lookup func(string) (string, bool)) error {
+	v := reflect.ValueOf(c).Elem()
+	t := v.Type()
+	var errs []error
+	for i := 0; i < t.NumField(); i++ {
+		key := t.Field(i).Tag.Get("yaml")
+		name := EnvPrefix + strings.ToUpper(key)
+		value, ok := lookup(name)
+		if !ok {
+			continue
+		}
+		if err := setField(v.Field(i), value); err != nil {
+			errs = append(errs, fmt.Errorf("env %s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func setField(field reflect.Value, value string) error {
+	switch {
+	case field.Addr().Type().Implements(reflect.TypeOf((*yaml.Unmarshaler)(nil)).Elem()):
+		return yaml.Unmarshal([]byte(value), field.Addr().Interface())
+	case field.Type() == reflect.TypeOf(time.Duration(0)):
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(d))
+	case field.Kind() == reflect.String:
+		field.SetString(value)
+	case field.Kind() == reflect.Int:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(n))
+	default:
+		return yaml.Unmarshal([]byte(value), field.Addr().Interface())
+	}
+	return nil
+}
+
+// RateLimit не больше Limit запросов за окно Window. Нулевой Limit отключает ограничение
+type RateLimit struct {
+	Limit  int64         `yaml:"limit"`
+	Window time.Duration `yaml:"window"`
+}
+
+// Level уровень логирования: число slog (-4 debug, 0 info, 4 warn, 8 error)
+// или имя уровня, например debug или warn+2
+type Level slog.Level
+
+func (l *Level) UnmarshalYAML(node *yaml.Node) error {
+	if n, err := strconv.Atoi(node.Value); err == nil {
+		*l = Level(n)
+		return nil
+	}
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(node.Value)); err != nil {
+		return err
+	}
+	*l = Level(level)
+	return nil
+}
+
+func (l Level) MarshalYAML() (interface{}, error) {
+	return slog.Level(l).String(), nil
+}
+
+// readSecretFiles читает секреты из файлов *_file. Файл имеет приоритет над значением в конфиге
+func (c *Config) readSecretFiles() error {
+	secrets := []struct {
+		file  string
+		value *string
+	}{
+		{c.JwtSecretFile, &c.JwtSecret},
+		{c.PasswordCacheFile, &c.PasswordCache},
+		{c.PasswordRBMQFile, &c.PasswordRBMQ},
+	}
+	for _, s := range secrets {
+		if s.file == "" {
+			continue
+		}
+		raw, err := os.ReadFile(s.file)
+		if err != nil {
+			return fmt.Errorf("read secret: %w", err)
+		}
+		*s.value = strings.TrimRight(string(raw), "\r\n")
+	}
+	return nil
+}
+
+func (c *Config) setDefaults() {
+	if c.Driver == "" {
+		c.Driver = "postgres"
+	}
+	if c.UserTimelineMaxLen == 0 {
+		c.UserTimelineMaxLen = DefaultUserTimelineMaxLen
+	}
+	if c.LogFormat == "" {
+		c.LogFormat = LogFormatText
+	}
+	if c.IdempotencyTTL == 0 {
+		c.IdempotencyTTL = DefaultIdempotencyTTL
+	}
+	for _, timeout := range []*time.Duration{&c.TimeOutCache, &c.TimeOutDB, &c.TimeOutRBMQ} {
+		if *timeout == 0 {
+			*timeout = c.TimeOut
+		}
+	}
+	if c.BreakerFailureThreshold == 0 {
+		c.BreakerFailureThreshold = DefaultBreakerFailureThreshold
+	}
+	if c.BreakerOpenTimeout == 0 {
+		c.BreakerOpenTimeout = DefaultBreakerOpenTimeout
+	}
+	if c.FallbackCache == "" {
+		c.FallbackCache = FallbackCacheSkip
+	}
+	if c.FallbackRBMQ == "" {
+		c.FallbackRBMQ = FallbackRBMQQueue
+	}
+	if c.QueueSizeRBMQ == 0 {
+		c.QueueSizeRBMQ = DefaultQueueSizeRBMQ
+	}
+	if c.ConcurrencyInitialLimit == 0 {
+		c.ConcurrencyInitialLimit = DefaultConcurrencyInitialLimit
+	}
+	if c.ConcurrencyMinLimit == 0 {
+		c.ConcurrencyMinLimit = DefaultConcurrencyMinLimit
+	}
+	if c.ConcurrencyMaxLimit == 0 {
+		c.ConcurrencyMaxLimit = DefaultConcurrencyMaxLimit
+	}
+	if c.ConcurrencyLatencyThreshold == 0 {
+		c.ConcurrencyLatencyThreshold = DefaultConcurrencyLatency
+	}
+	if c.CacheTopology == "" {
+		c.CacheTopology = CacheTopologyStandalone
+	}
+	if c.CacheKeyPrefix == "" {
+		c.CacheKeyPrefix = DefaultCacheKeyPrefix
+	}
+	if c.LocalCacheSize == nil {
+		size := DefaultLocalCacheSize
+		c.LocalCacheSize = &size
+	}
+	if c.LocalCacheTTL == 0 {
+		c.LocalCacheTTL = DefaultLocalCacheTTL
+	}
+	if c.TweetCacheTTL == 0 {
+		c.TweetCacheTTL = DefaultTweetCacheTTL
+	}
+	if c.UserTimelineTTL == 0 {
+		c.UserTimelineTTL = DefaultUserTimelineTTL
+	}
+	if c.TweetNotFoundTTL == 0 {
+		c.TweetNotFoundTTL = DefaultTweetNotFoundTTL
+	}
+	if c.PollVotesTTL == 0 {
+		c.PollVotesTTL = DefaultPollVotesTTL
+	}
+	if c.ClosedPollVotesTTL == 0 {
+		c.ClosedPollVotesTTL = DefaultClosedPollVotesTTL
+	}
+	if c.TraceExporter == "" {
+		c.TraceExporter = TraceExporterNone
+	}
+	if c.TraceSampleRatio == 0 {
+		c.TraceSampleRatio = 1
+	}
+}
+
+// CacheAddrs адреса Redis из addr_cache
+func (c Config) CacheAddrs() []string {
+	var addrs []string
+	for _, addr := range strings.Split(c.AddrCache, ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs
+}
+
+// Validate проверяет конфиг и возвращает все найденные ошибки сразу
+func (c Config) Validate() error {
+	var errs []error
+	required := []struct {
+		key   string
+		value string
+	}{
+		{"dsn", c.DSN},
+		{"host", c.Host},
+		{"host_grpc", c.HostGRPC},
+		{"migrate_dir", c.MigrateDir},
+		{"jwt_secret", c.JwtSecret},
+		{"addr_cache", c.AddrCache},
+		{"host_rbmq", c.HostRBMQ},
+		{"port_rbmq", c.PortRBMQ},
+		{"media_dir", c.MediaDir},
+	}
+	for _, r := range required {
+		if r.value == "" {
+			errs = append(errs, fmt.Errorf("%s is required", r.key))
+		}
+	}
+
+	for key, value := range map[string]time.Duration{
+		"timeout":                       c.TimeOut,
+		"idempotency_ttl":               c.IdempotencyTTL,
+		"local_cache_ttl":               c.LocalCacheTTL,
+		"timeout_cache":                 c.TimeOutCache,
+		"timeout_db":                    c.TimeOutDB,
+		"timeout_rbmq":                  c.TimeOutRBMQ,
+		"breaker_open_timeout":          c.BreakerOpenTimeout,
+		"concurrency_latency_threshold": c.ConcurrencyLatencyThreshold,
+		"cache_dial_timeout":            c.CacheDialTimeout,
+		"cache_read_timeout":            c.CacheReadTimeout,
+		"cache_write_timeout":           c.CacheWriteTimeout,
+		"cache_pool_timeout":            c.CachePoolTimeout,
+		"tweet_cache_ttl":               c.TweetCacheTTL,
+		"tweet_not_found_ttl":           c.TweetNotFoundTTL,
+		"user_timeline_ttl":             c.UserTimelineTTL,
+		"poll_votes_ttl":                c.PollVotesTTL,
+		"closed_poll_votes_ttl":         c.ClosedPollVotesTTL,
+	} {
+		if value < 0 {
+			errs = append(errs, fmt.Errorf("%s must not be negative, got %s", key, value))
+		}
+	}
+	// публикация ждет подтверждения брокера, без дедлайна зависший брокер держит запрос бесконечно
+	if c.TimeOutRBMQ == 0 {
+		errs = append(errs, errors.New("timeout_rbmq or timeout is required"))
+	}
+	if c.CacheCompressMinSize < 0 {
+		errs = append(errs, fmt.Errorf("cache_compress_min_size must not be negative, got %d", c.CacheCompressMinSize))
+	}
+	if c.LocalCacheSize != nil && *c.LocalCacheSize < 0 {
+		errs = append(errs, fmt.Errorf("local_cache_size must not be negative, got %d", *c.LocalCacheSize))
+	}
+	if c.UserTimelineMaxLen < 0 {
+		errs = append(errs, fmt.Errorf("user_timeline_max_len must not be negative, got %d", c.UserTimelineMaxLen))
+	}
+	for key, value := range map[string]int{
+		"cache_pool_size":           c.CachePoolSize,
+		"cache_min_idle_conns":      c.CacheMinIdleConns,
+		"breaker_failure_threshold": c.BreakerFailureThreshold,
+		"queue_size_rbmq":           c.QueueSizeRBMQ,
+		"concurrency_initial_limit": c.ConcurrencyInitialLimit,
+		"concurrency_min_limit":     c.ConcurrencyMinLimit,
+		"concurrency_max_limit":     c.ConcurrencyMaxLimit,
+	} {
+		if value < 0 {
+			errs = append(errs, fmt.Errorf("%s must not be negative, got %d", key, value))
+		}
+	}
+	if c.ConcurrencyMinLimit > c.ConcurrencyMaxLimit {
+		errs = append(errs, fmt.Errorf("concurrency_min_limit %d must not exceed concurrency_max_limit %d",
+			c.ConcurrencyMinLimit, c.ConcurrencyMaxLimit))
+	}
+	if c.FallbackCache != FallbackCacheSkip && c.FallbackCache != FallbackCacheFail {
+		errs = append(errs, fmt.Errorf("fallback_cache must be skip or fail, got %q", c.FallbackCache))
+	}
+	if c.FallbackRBMQ != FallbackRBMQQueue && c.FallbackRBMQ != FallbackRBMQDrop {
+		errs = append(errs, fmt.Errorf("fallback_rbmq must be queue or drop, got %q", c.FallbackRBMQ))
+	}
+	switch c.CacheTopology {
+	case CacheTopologyStandalone:
+		if len(c.CacheAddrs()) > 1 {
+			errs = append(errs, fmt.Errorf("addr_cache must be a single address for cache_topology: standalone"))
+		}
+	case CacheTopologySentinel:
+		if c.CacheMasterName == "" {
+			errs = append(errs, errors.New("cache_master_name is required for cache_topology: sentinel"))
+		}
+	case CacheTopologyCluster:
+	default:
+		errs = append(errs, fmt.Errorf("cache_topology must be one of standalone, sentinel, cluster, got %q", c.CacheTopology))
+	}
+
+	for method, rule := range c.RateLimits {
+		if err := validateRateLimit(rule); err != nil {
+			errs = append(errs, fmt.Errorf("rate_limits.%s: %w", method, err))
+		}
+	}
+	if err := validateRateLimit(c.HTTPRateLimit); err != nil {
+		errs = append(errs, fmt.Errorf("http_rate_limit: %w", err))
+	}
+
+	if c.LogFormat != LogFormatText && c.LogFormat != LogFormatJSON {
+		errs = append(errs, fmt.Errorf("log_format must be text or json, got %q", c.LogFormat))
+	}
+
+	switch c.TraceExporter {
+	case TraceExporterNone, TraceExporterOTLP, TraceExporterStdout:
+	case TraceExporterFile:
+		if c.TraceFile == "" {
+			errs = append(errs, errors.New("trace_file is required for trace_exporter: file"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("trace_exporter must be one of none, otlp, stdout, file, got %q", c.TraceExporter))
+	}
+	if c.TraceSampleRatio < 0 || c.TraceSampleRatio > 1 {
+		errs = append(errs, fmt.Errorf("trace_sample_ratio must be within 0..1, got %g", c.TraceSampleRatio))
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("invalid config:\n%w", err)
+	}
+	return nil
+}
+
+func validateRateLimit(rule RateLimit) error {
+	if rule.Limit < 0 {
+		return fmt.Errorf("limit must not be negative, got %d", rule.Limit)
+	}
+	if rule.Limit > 0 && rule.Window <= 0 {
+		return errors.New("window is required when limit is set")
+	}
+	return nil
+}
+
+// Redacted копия конфига без секретов: пароли и ключи заменены, из DSN убран пароль
+func (c Config) Redacted() Config {
+	for _, s := range []*string{&c.JwtSecret, &c.PasswordCache, &c.PasswordRBMQ} {
+		if *s != "" {
+			*s = redactedValue
+		}
+	}
+	c.DSN = redactDSN(c.DSN)
+	return c
+}
+
+// dsnPassword пароль в DSN вида "host=... password=..." или в параметрах URL
+var dsnPassword = regexp.MustCompile(`password=('[^']*'|[^\s&]*)`)
+
+func redactDSN(dsn string) string {
+	if u, err := url.Parse(dsn); err == nil && u.Scheme != "" {
+		dsn = u.Redacted()
+	}
+	return dsnPassword.ReplaceAllString(dsn, "password="+redactedValue)
+}
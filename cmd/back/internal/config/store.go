@@ -0,0 +1,121 @@
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// reloadableKeys поля, которые применяются при перезагрузке. Остальные изменения
+// в файле игнорируются до перезапуска
+var reloadableKeys = map[string]bool{
+	"loglevel":              true,
+	"jwt_secret":            true,
+	"jwt_secret_file":       true,
+	"tweet_cache_ttl":       true,
+	"tweet_not_found_ttl":   true,
+	"poll_votes_ttl":        true,
+	"closed_poll_votes_ttl": true,
+	"user_timeline_max_len": true,
+	"user_timeline_ttl":     true,
+	"admin_user_ids":        true,
+}
+
+// Snapshot загруженный конфиг и его версия
+type Snapshot struct {
+	Config Config
+	// Version 1 при старте и +1 на каждую успешную перезагрузку
+	Version  int64
+	Checksum string
+	LoadedAt time.Time
+}
+
+// Store хранит активный конфиг и уведомляет подписчиков о перезагрузках
+type Store struct {
+	path string
+	// reloadMu не дает двум перезагрузкам перемешать уведомления подписчиков
+	reloadMu    sync.Mutex
+	mu          sync.Mutex
+	current     Snapshot
+	subscribers []func(Snapshot)
+}
+
+func NewStore(path string, cfg Config) *Store {
+	return &Store{
+		path:    path,
+		current: Snapshot{Config: cfg, Version: 1, Checksum: checksum(cfg), LoadedAt: time.Now()},
+	}
+}
+
+func (s *Store) Current() Snapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.current
+}
+
+// Subscribe регистрирует обработчик новых версий конфига. Подписчики вызываются
+// последовательно в порядке подписки
+func (s *Store) Subscribe(fn func(Snapshot)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subscribers = append(s.subscribers, fn)
+}
+
+// Reload перечитывает файл и применяет изменившиеся перезагружаемые поля.
+// Возвращает новую версию и yaml-ключи изменений, для которых нужен перезапуск.
+// При ошибке чтения или проверки активный конфиг не меняется
+func (s *Store) Reload() (Snapshot, []string, error) {
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+
+	loaded, err := Load(s.path)
+	if err != nil {
+		return Snapshot{}, nil, err
+	}
+
+	s.mu.Lock()
+	next := s.current.Config
+	var ignored []string
+	nextValue := reflect.ValueOf(&next).Elem()
+	loadedValue := reflect.ValueOf(loaded)
+	for i := 0; i < nextValue.NumField(); i++ {
+		key := nextValue.Type().Field(i).Tag.Get("yaml")
+		if reflect.DeepEqual(nextValue.Field(i).Interface(), loadedValue.Field(i).Interface()) {
+			continue
+		}
+		if !reloadableKeys[key] {
+			ignored = append(ignored, key)
+			continue
+		}
+		nextValue.Field(i).Set(loadedValue.Field(i))
+	}
+	s.current = Snapshot{
+		Config:   next,
+		Version:  s.current.Version + 1,
+		Checksum: checksum(next),
+		LoadedAt: time.Now(),
+	}
+	snapshot := s.current
+	subscribers := append([]func(Snapshot){}, s.subscribers...)
+	s.mu.Unlock()
+
+	for _, fn := range subscribers {
+		fn(snapshot)
+	}
+	return snapshot, ignored, nil
+}
+
+// checksum считается по конфигу без секретов, чтобы ее можно было отдавать наружу
+func checksum(cfg Config) string {
+	raw, err := yaml.Marshal(cfg.Redacted())
+	if err != nil {
+		return fmt.Sprintf("error: %v", err)
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,159 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+	"twitter/cmd/back/internal/app"
+	"twitter/internal/logger"
+	"twitter/internal/rabbitmq"
+	"twitter/internal/requestid"
+	"twitter/internal/tracing"
+
+	"github.com/gofrs/uuid/v5"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("twitter/cmd/back/internal/consumer")
+
+const (
+	// prefetch сколько неподтвержденных сообщений брокер держит у потребителя
+	prefetch = 10
+
+	// Пауза перед возвратом сообщения в очередь после ошибки растет вдвое от minRetryDelay
+	// до maxRetryDelay, пока обработка не пройдет успешно
+	minRetryDelay = time.Second
+	maxRetryDelay = time.Minute
+)
+
+type BookmarkRepository interface {
+	DeleteBookmarksByTweetFromDB(ctx context.Context, tweetId uuid.UUID) error
+}
+
+// ChannelOpener открывает канал для чтения очереди на текущем соединении с брокером
+type ChannelOpener interface {
+	OpenChannel() (*amqp.Channel, error)
+}
+
+// BookmarkCleaner удаляет закладки на твиты по событиям удаления
+type BookmarkCleaner struct {
+	channels ChannelOpener
+	repo     BookmarkRepository
+}
+
+func NewBookmarkCleaner(channels ChannelOpener, repo BookmarkRepository) *BookmarkCleaner {
+	return &BookmarkCleaner{channels: channels, repo: repo}
+}
+
+// Run читает очередь до отмены ctx. После закрытия канала, например при разрыве соединения,
+// подписка повторяется на новом канале. При отмене ctx брокер перестает присылать сообщения,
+// а уже полученное обрабатывается до конца
+func (c *BookmarkCleaner) Run(ctx context.Context) {
+	delay := rabbitmq.MinReconnectDelay
+	for {
+		err := c.consume(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil {
+			// канал работал и закрылся: соединение скорее всего уже восстанавливается
+			delay = rabbitmq.MinReconnectDelay
+		} else {
+			logger.FromContext(ctx).WarnContext(ctx, "bookmark cleaner: consume failed, retrying", "error", err, "delay", delay)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		if err != nil {
+			delay = min(delay*2, rabbitmq.MaxReconnectDelay)
+		}
+	}
+}
+
+// consume читает очередь на новом канале, пока он открыт
+func (c *BookmarkCleaner) consume(ctx context.Context) error {
+	channel, err := c.channels.OpenChannel()
+	if err != nil {
+		return fmt.Errorf("failed to open channel: %w", err)
+	}
+	defer channel.Close()
+
+	// без лимита брокер при недоступной базе выгрузит потребителю всю очередь
+	if err := channel.Qos(prefetch, 0, false); err != nil {
+		return fmt.Errorf("failed to set prefetch: %w", err)
+	}
+
+	deliveries, err := channel.ConsumeWithContext(ctx,
+		rabbitmq.TweetDeletedQueue, // queue
+		"bookmark-cleaner",         // consumer
+		false,                      // auto-ack
+		false,                      // exclusive
+		false,                      // no-local
+		false,                      // no-wait
+		nil,                        // args
+	)
+	if err != nil {
+		return fmt.Errorf("failed to consume %s: %w", rabbitmq.TweetDeletedQueue, err)
+	}
+
+	var delay time.Duration
+	for d := range deliveries {
+		if err := c.handle(ctx, d); err == nil {
+			delay = 0
+			continue
+		}
+		// сразу возвращенное сообщение тут же придет снова: ждем, пока база не поднимется.
+		// При остановке возвращаем без ожидания
+		delay = min(max(delay*2, minRetryDelay), maxRetryDelay)
+		select {
+		case <-ctx.Done():
+		case <-time.After(delay):
+		}
+		d.Nack(false, true)
+	}
+	return nil
+}
+
+// handle обрабатывает одно сообщение в трассировке, начатой при публикации. Ошибка значит,
+// что сообщение не подтверждено и его нужно вернуть в очередь
+func (c *BookmarkCleaner) handle(ctx context.Context, d amqp.Delivery) error {
+	// начатое сообщение дообрабатываем и при остановке, чтобы не гонять его на повторную доставку
+	ctx = tracing.ExtractAMQP(context.WithoutCancel(ctx), d.Headers)
+	ctx, span := tracer.Start(ctx, rabbitmq.TweetDeletedQueue+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "rabbitmq"),
+			attribute.String("messaging.operation.type", "process"),
+			attribute.String("messaging.destination.name", rabbitmq.TweetDeletedQueue),
+		),
+	)
+	defer span.End()
+	log := logger.FromContext(ctx)
+	if d.CorrelationId != "" {
+		ctx = requestid.NewContext(ctx, d.CorrelationId)
+		log = log.With("request_id", d.CorrelationId)
+	}
+
+	var event app.TweetDeletedEvent
+	if err := json.Unmarshal(d.Body, &event); err != nil {
+		// битое сообщение не обработать повторно, выбрасываем
+		log.ErrorContext(ctx, "bookmark cleaner: invalid event", "error", err)
+		span.SetStatus(codes.Error, err.Error())
+		d.Nack(false, false)
+		return nil
+	}
+	if err := c.repo.DeleteBookmarksByTweetFromDB(ctx, event.TweetId); err != nil {
+		log.ErrorContext(ctx, "bookmark cleaner: delete failed", "tweet_id", event.TweetId.String(), "error", err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	d.Ack(false)
+	return nil
+}
@@ -0,0 +1,122 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+	"twitter/internal/logger"
+
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// CheckFunc проверяет одну зависимость сервиса. nil значит, что зависимость доступна
+type CheckFunc func(ctx context.Context) error
+
+type check struct {
+	name string
+	fn   CheckFunc
+}
+
+// Checker собирает проверки зависимостей для readiness. Проверки добавляются при старте,
+// до первого вызова Check
+type Checker struct {
+	checks  []check
+	timeout time.Duration
+}
+
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{timeout: timeout}
+}
+
+func (c *Checker) Add(name string, fn CheckFunc) {
+	c.checks = append(c.checks, check{name: name, fn: fn})
+}
+
+// Check параллельно выполняет все проверки, каждую со своим таймаутом.
+// Возвращает ошибки по имени проверки, для успешных проверок значение nil
+func (c *Checker) Check(ctx context.Context) map[string]error {
+	results := make(map[string]error, len(c.checks))
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for _, ch := range c.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, c.timeout)
+			defer cancel()
+			err := ch.fn(ctx)
+			mu.Lock()
+			results[ch.name] = err
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+type readinessResponse struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"`
+}
+
+// LivenessHandler отвечает 200, пока процесс жив. Зависимости не проверяются,
+// чтобы оркестратор не перезапускал сервис из-за упавшей базы
+func LivenessHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(`{"status":"ok"}`))
+}
+
+// ReadinessHandler отвечает 200, если все зависимости доступны, иначе 503 со списком проверок.
+// Ручка открыта без авторизации, поэтому причины отказа, в которых бывают внутренние адреса,
+// только пишутся в лог
+func (c *Checker) ReadinessHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	results := c.Check(ctx)
+	resp := readinessResponse{Status: "ok", Checks: make(map[string]string, len(results))}
+	code := http.StatusOK
+	for name, err := range results {
+		if err != nil {
+			resp.Status = "unavailable"
+			resp.Checks[name] = "unavailable"
+			logger.FromContext(ctx).WarnContext(ctx, "readiness check failed", "check", name, "error", err)
+			code = http.StatusServiceUnavailable
+			continue
+		}
+		resp.Checks[name] = "ok"
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// Run раз в interval обновляет статус grpc.health.v1 для всего сервера и перечисленных сервисов,
+// пока не отменен ctx
+func (c *Checker) Run(ctx context.Context, server *health.Server, interval time.Duration, services ...string) {
+	services = append(services, "")
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		status := grpc_health_v1.HealthCheckResponse_SERVING
+		for _, err := range c.Check(ctx) {
+			if err != nil {
+				status = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+				break
+			}
+		}
+		for _, service := range services {
+			server.SetServingStatus(service, status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
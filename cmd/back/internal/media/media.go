@@ -0,0 +1,113 @@
+package media
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	"golang.org/x/image/draw"
+)
+
+const (
+	// MaxSize максимальный размер загружаемого файла
+	MaxSize = 5 << 20
+	// MaxPixels максимальное число пикселей исходного изображения, защищает от распаковки огромных
+	// картинок: распакованное изображение занимает до 4 байт на пиксель
+	MaxPixels = 40_000_000
+	// ThumbnailSize максимальная сторона превью в пикселях
+	ThumbnailSize = 320
+)
+
+var (
+	ErrUnsupportedType = errors.New("unsupported media type")
+	ErrTooLarge        = errors.New("media file is too large")
+	ErrInvalidImage    = errors.New("invalid image")
+)
+
+// Image обработанное изображение, готовое к сохранению
+type Image struct {
+	MimeType  string
+	Data      []byte
+	Thumbnail []byte
+	Width     int
+	Height    int
+}
+
+// Process проверяет тип и размер файла, перекодирует изображение и строит превью.
+// Перекодирование отбрасывает все метаданные файла, в том числе EXIF
+func Process(data []byte, mimeType string) (Image, error) {
+	if len(data) > MaxSize {
+		return Image{}, ErrTooLarge
+	}
+	if mimeType != "image/jpeg" && mimeType != "image/png" {
+		return Image{}, ErrUnsupportedType
+	}
+	// тип определяется по содержимому, заявленному клиентом типу не доверяем
+	if http.DetectContentType(data) != mimeType {
+		return Image{}, ErrUnsupportedType
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return Image{}, ErrInvalidImage
+	}
+	if int64(cfg.Width)*int64(cfg.Height) > MaxPixels {
+		return Image{}, ErrTooLarge
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return Image{}, ErrInvalidImage
+	}
+
+	clean, err := encode(src, mimeType)
+	if err != nil {
+		return Image{}, err
+	}
+	thumbnail, err := encode(thumbnail(src), mimeType)
+	if err != nil {
+		return Image{}, err
+	}
+
+	bounds := src.Bounds()
+	return Image{
+		MimeType:  mimeType,
+		Data:      clean,
+		Thumbnail: thumbnail,
+		Width:     bounds.Dx(),
+		Height:    bounds.Dy(),
+	}, nil
+}
+
+func encode(img image.Image, mimeType string) ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+	if mimeType == "image/png" {
+		err = png.Encode(&buf, img)
+	} else {
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: 90})
+	}
+	return buf.Bytes(), err
+}
+
+// thumbnail уменьшает изображение с сохранением пропорций. Маленькие изображения не увеличиваются
+func thumbnail(src image.Image) image.Image {
+	bounds := src.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	if w <= ThumbnailSize && h <= ThumbnailSize {
+		return src
+	}
+	if w >= h {
+		h = max(1, h*ThumbnailSize/w)
+		w = ThumbnailSize
+	} else {
+		w = max(1, w*ThumbnailSize/h)
+		h = ThumbnailSize
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, bounds, draw.Over, nil)
+	return dst
+}
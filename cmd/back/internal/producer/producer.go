@@ -3,26 +3,100 @@ package producer
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
+	"twitter/internal/breaker"
+	"twitter/internal/logger"
+	"twitter/internal/metrics"
+	"twitter/internal/requestid"
+	"twitter/internal/tracing"
 
 	amqp "github.com/rabbitmq/amqp091-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var tracer = otel.Tracer("twitter/cmd/back/internal/producer")
+
+// Поведение при недоступном брокере
+const (
+	// FallbackDrop возвращает ошибку публикации вызывающему коду
+	FallbackDrop = "drop"
+	// FallbackQueue откладывает событие в очередь в памяти и повторяет публикацию в фоне
+	FallbackQueue = "queue"
+
+	retryInterval = time.Second
+	// maxPublishWait дедлайн публикации, если его не задали ни запрос, ни breaker
+	maxPublishWait = 10 * time.Second
+)
+
+// Options настройки устойчивости публикации. Нулевые значения - без breaker и без очереди
+type Options struct {
+	Breaker  *breaker.Breaker
+	Fallback string
+	// QueueSize сколько событий держать в очереди при Fallback: queue. Значение по умолчанию
+	// подставляет config
+	QueueSize int
+}
+
+// ChannelSource отдает текущий канал публикации в режиме подтверждений. После разрыва
+// соединения канал меняется, поэтому его берут на каждую публикацию
+type ChannelSource interface {
+	Channel() (*amqp.Channel, error)
+}
+
+// errNotConfirmed брокер отказался принять сообщение (nack)
+var errNotConfirmed = errors.New("rabbitmq publish not confirmed")
+
 type Producer struct {
-	channel *amqp.Channel
+	channels ChannelSource
+	opts     Options
+
+	// retryMu не дает фоновому повтору и Flush публиковать отложенные события одновременно
+	retryMu sync.Mutex
+	mu      sync.Mutex
+	// queued события, отложенные при недоступном брокере, в порядке публикации
+	queued []queuedEvent
 }
 
-func NewProducer(channel *amqp.Channel) *Producer {
-	return &Producer{channel: channel}
+type queuedEvent struct {
+	routingKey string
+	msg        amqp.Publishing
 }
 
-// PublishJSON публикует сообщение в формате JSON
-func (p *Producer) PublishJSON(ctx context.Context, routingKey string, message interface{}) error {
+func NewProducer(channels ChannelSource, opts Options) *Producer {
+	return &Producer{channels: channels, opts: opts}
+}
+
+// PublishJSON публикует сообщение в формате JSON. Контекст трассировки передается в заголовках,
+// чтобы потребитель продолжил ту же трассировку, а идентификатор запроса - в CorrelationId
+func (p *Producer) PublishJSON(ctx context.Context, routingKey string, message interface{}) (err error) {
+	ctx, span := tracer.Start(ctx, routingKey+" publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "rabbitmq"),
+			attribute.String("messaging.operation.type", "send"),
+			attribute.String("messaging.destination.name", routingKey),
+		),
+	)
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
 	body, err := json.Marshal(message)
 	if err != nil {
 		return fmt.Errorf("failed to marshal message: %w", err)
 	}
+	headers := amqp.Table{}
+	tracing.InjectAMQP(ctx, headers)
 
 	// // Объявляем exchange если не существует
 	// err = p.channel.ExchangeDeclare(
@@ -38,19 +112,133 @@ func (p *Producer) PublishJSON(ctx context.Context, routingKey string, message i
 	// 	return fmt.Errorf("failed to declare exchange: %w", err)
 	// }
 
-	// Публикуем сообщение
-	err = p.channel.PublishWithContext(ctx,
-		"",         // exchange
-		routingKey, // routing key
-		false,      // mandatory
-		false,      // immediate
-		amqp.Publishing{
-			ContentType:  "application/json",
-			Body:         body,
-			DeliveryMode: amqp.Persistent, // Сохранять при перезапуске
-			Timestamp:    time.Now(),
-		},
-	)
+	msg := amqp.Publishing{
+		Headers:       headers,
+		CorrelationId: requestid.FromContext(ctx),
+		ContentType:   "application/json",
+		Body:          body,
+		DeliveryMode:  amqp.Persistent, // Сохранять при перезапуске
+		Timestamp:     time.Now(),
+	}
+	if p.opts.Fallback != FallbackQueue {
+		return p.publish(ctx, routingKey, msg)
+	}
+	// пока очередь не разобрана, новые события встают за ней, чтобы сохранить порядок
+	if p.queueLen() == 0 {
+		if err = p.publish(ctx, routingKey, msg); err == nil {
+			return nil
+		}
+	} else {
+		err = errors.New("earlier events are still queued")
+	}
+	if qerr := p.enqueue(routingKey, msg); qerr != nil {
+		return errors.Join(err, qerr)
+	}
+	logger.FromContext(ctx).WarnContext(ctx, "rabbitmq unavailable, event queued", "routing_key", routingKey, "error", err)
+	return nil
+}
+
+// publish отправляет сообщение через breaker и ждет подтверждения брокера. Отказ брокера
+// и отсутствие подтверждения до дедлайна считаются ошибкой публикации. Отмена запроса
+// клиентом публикацию не прерывает: сообщение могло уже уйти брокеру. Дедлайн запроса
+// сохраняется, а без него действует maxPublishWait
+func (p *Producer) publish(ctx context.Context, routingKey string, msg amqp.Publishing) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(maxPublishWait)
+	}
+	ctx, cancel := context.WithDeadline(context.WithoutCancel(ctx), deadline)
+	defer cancel()
+	return p.opts.Breaker.Do(ctx, func(ctx context.Context) error {
+		channel, err := p.channels.Channel()
+		if err != nil {
+			return err
+		}
+		confirm, err := channel.PublishWithDeferredConfirmWithContext(ctx,
+			"",         // exchange
+			routingKey, // routing key
+			false,      // mandatory
+			false,      // immediate
+			msg,
+		)
+		if err != nil {
+			return err
+		}
+		acked, err := confirm.WaitContext(ctx)
+		if err != nil {
+			return fmt.Errorf("wait rabbitmq confirm: %w", err)
+		}
+		if !acked {
+			return errNotConfirmed
+		}
+		return nil
+	})
+}
+
+func (p *Producer) enqueue(routingKey string, msg amqp.Publishing) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.queued) >= p.opts.QueueSize {
+		return fmt.Errorf("event queue is full (%d events)", len(p.queued))
+	}
+	p.queued = append(p.queued, queuedEvent{routingKey: routingKey, msg: msg})
+	metrics.QueuedEvents.Set(float64(len(p.queued)))
+	return nil
+}
+
+func (p *Producer) queueLen() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.queued)
+}
 
-	return err
+// RunRetries повторяет публикацию отложенных событий, пока не отменен ctx
+func (p *Producer) RunRetries(ctx context.Context) {
+	ticker := time.NewTicker(retryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.retryQueued(ctx)
+		}
+	}
+}
+
+// retryQueued публикует отложенные события по порядку до первой ошибки
+func (p *Producer) retryQueued(ctx context.Context) {
+	p.retryMu.Lock()
+	defer p.retryMu.Unlock()
+	for {
+		p.mu.Lock()
+		if len(p.queued) == 0 {
+			p.mu.Unlock()
+			return
+		}
+		event := p.queued[0]
+		p.mu.Unlock()
+
+		if err := p.publish(ctx, event.routingKey, event.msg); err != nil {
+			if !errors.Is(err, breaker.ErrOpen) {
+				logger.FromContext(ctx).WarnContext(ctx, "rabbitmq republish failed", "routing_key", event.routingKey, "error", err)
+			}
+			return
+		}
+
+		p.mu.Lock()
+		p.queued = p.queued[1:]
+		metrics.QueuedEvents.Set(float64(len(p.queued)))
+		p.mu.Unlock()
+	}
+}
+
+// Flush последний раз пытается отправить отложенные события. Отправленные ранее сообщения
+// к этому моменту уже подтверждены брокером
+func (p *Producer) Flush(ctx context.Context) error {
+	p.retryQueued(ctx)
+	if lost := p.queueLen(); lost > 0 {
+		return fmt.Errorf("flush rabbitmq publishes: %d queued events dropped", lost)
+	}
+	return nil
 }
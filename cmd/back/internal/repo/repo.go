@@ -3,69 +3,152 @@ package repo
 import (
 	"context"
 	"database/sql"
-	"fmt"
+	"errors"
+	"time"
 	"twitter/cmd/back/internal/app"
+	"twitter/internal/breaker"
 
 	"github.com/gofrs/uuid/v5"
 	"github.com/lib/pq"
 )
 
 type Repository struct {
-	db *sql.DB
+	db tracedDB
 }
 
-func NewRepository(rawDB *sql.DB) *Repository {
-	return &Repository{db: rawDB}
+// NewRepository создает репозиторий. b может быть nil
+func NewRepository(rawDB *sql.DB, b *breaker.Breaker) *Repository {
+	return &Repository{db: tracedDB{DB: rawDB, breaker: b}}
 }
 
 func (d Repository) CreateTweetToDB(ctx context.Context, tweet app.Tweet) (app.Tweet, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return app.Tweet{}, err
+	}
+	defer tx.Rollback()
+
 	query := `insert into tweets (text, user_id) values ($1, $2) returning *`
-	err := d.db.QueryRowContext(ctx, query, tweet.Text, tweet.UserId).Scan(&tweet.Id, &tweet.Text,
-		&tweet.CreatedAt, &tweet.UpdatedAt, &tweet.UserId)
+	err = tx.QueryRowContext(ctx, query, tweet.Text, tweet.UserId).Scan(&tweet.Id, &tweet.Text,
+		&tweet.CreatedAt, &tweet.UpdatedAt, &tweet.UserId, &tweet.Version)
 	if err != nil {
 		return app.Tweet{}, err
 	}
+
+	if tweet.Poll != nil {
+		tweet.Poll.TweetId = tweet.Id
+		tweet.Poll.ClosesAt = tweet.Poll.ClosesAt.UTC()
+		_, err = tx.ExecContext(ctx, `insert into polls (tweet_id, closes_at) values ($1, $2)`,
+			tweet.Poll.TweetId, tweet.Poll.ClosesAt)
+		if err != nil {
+			return app.Tweet{}, err
+		}
+		for _, option := range tweet.Poll.Options {
+			_, err = tx.ExecContext(ctx, `insert into poll_options (tweet_id, position, text) values ($1, $2, $3)`,
+				tweet.Poll.TweetId, option.Position, option.Text)
+			if err != nil {
+				return app.Tweet{}, err
+			}
+		}
+	}
+
+	// прикрепить можно только собственные загруженные файлы
+	query = `with m as (
+		select id, mime_type, size, width, height, created_at from media where id = $2 and user_id = $5
+	), ins as (
+		insert into tweet_media (tweet_id, media_id, position, alt_text) select $1, id, $3, $4 from m
+	)
+	select mime_type, size, width, height, created_at from m`
+	for i := range tweet.Media {
+		m := &tweet.Media[i]
+		m.UserId = tweet.UserId
+		err = tx.QueryRowContext(ctx, query, tweet.Id, m.Id, i, m.AltText, tweet.UserId).Scan(&m.MimeType, &m.Size,
+			&m.Width, &m.Height, &m.CreatedAt)
+		if err == sql.ErrNoRows {
+			return app.Tweet{}, app.ErrMediaNotFound
+		}
+		if err != nil {
+			return app.Tweet{}, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return app.Tweet{}, err
+	}
 	return tweet, nil
 }
 
+// tweetColumns колонки твита в порядке scanTweet
+const tweetColumns = `id, text, created_at, updated_at, user_id, version`
+
+func scanTweet(row interface{ Scan(...any) error }) (app.Tweet, error) {
+	var tweet app.Tweet
+	err := row.Scan(&tweet.Id, &tweet.Text, &tweet.CreatedAt, &tweet.UpdatedAt, &tweet.UserId, &tweet.Version)
+	return tweet, err
+}
+
 func (d Repository) GetTweetByIDFromDB(ctx context.Context, tweet app.Tweet) (app.Tweet, error) {
-	query := `select * from tweets where id = $1`
+	query := `select ` + tweetColumns + ` from tweets where id = $1`
 
-	err := d.db.QueryRowContext(ctx, query, tweet.Id).Scan(&tweet.Id, &tweet.Text,
-		&tweet.CreatedAt, &tweet.UpdatedAt, &tweet.UserId)
+	tweet, err := scanTweet(d.db.QueryRowContext(ctx, query, tweet.Id))
+	if err == sql.ErrNoRows {
+		return app.Tweet{}, app.ErrTweetNotFound
+	}
 	if err != nil {
 		return app.Tweet{}, err
 	}
 	return tweet, nil
 }
 
-func (d Repository) GetUserTweetsFromDB(ctx context.Context, userId uuid.UUID) ([]app.Tweet, error) {
-	query := `select * from tweets where user_id = $1`
-	row, err := d.db.QueryContext(ctx, query, userId)
+// GetUserTweetIDsFromDB возвращает id и время создания limit последних твитов пользователя,
+// новые первыми. limit 0 - все твиты
+func (d Repository) GetUserTweetIDsFromDB(ctx context.Context, userId uuid.UUID, limit int) ([]app.Tweet, error) {
+	query := `select id, created_at from tweets where user_id = $1
+	order by created_at desc, id desc
+	limit nullif($2, 0)`
+	row, err := d.db.QueryContext(ctx, query, userId, limit)
 	if err != nil {
-		return []app.Tweet{}, err
+		return nil, err
 	}
+	defer row.Close()
 	var tweets []app.Tweet
 	for row.Next() {
-		var tweet app.Tweet
-		row.Scan(&tweet.Id, &tweet.Text,
-			&tweet.CreatedAt, &tweet.UpdatedAt, &tweet.UserId)
+		tweet := app.Tweet{UserId: userId}
+		if err := row.Scan(&tweet.Id, &tweet.CreatedAt); err != nil {
+			return nil, err
+		}
 		tweets = append(tweets, tweet)
 	}
-	return tweets, nil
+	return tweets, row.Err()
 }
 
+// UpdateTweetToDB меняет текст и увеличивает версию твита. Если tweet.Version не ноль, обновление
+// проходит только при совпадении с текущей версией, иначе возвращается ErrVersionConflict
 func (d Repository) UpdateTweetToDB(ctx context.Context, tweet app.Tweet) (app.Tweet, error) {
-	fmt.Println("----", tweet.Id, tweet.UserId, "-", tweet.Text)
 	query := `update tweets
 	set
-	text = $1
-	where id = $2 and user_id = $3
+	text = $1,
+	updated_at = now(),
+	version = version + 1
+	where id = $2 and user_id = $3 and ($4 = 0 or version = $4)
 	returning *
 	`
 
-	err := d.db.QueryRowContext(ctx, query, tweet.Text, tweet.Id, tweet.UserId).Scan(&tweet.Id, &tweet.Text,
-		&tweet.CreatedAt, &tweet.UpdatedAt, &tweet.UserId)
+	expected := tweet.Version
+	err := d.db.QueryRowContext(ctx, query, tweet.Text, tweet.Id, tweet.UserId, expected).Scan(&tweet.Id, &tweet.Text,
+		&tweet.CreatedAt, &tweet.UpdatedAt, &tweet.UserId, &tweet.Version)
+	if err == sql.ErrNoRows {
+		// разделяем чужой или несуществующий твит и устаревшую версию
+		var exists bool
+		query = `select exists (select 1 from tweets where id = $1 and user_id = $2)`
+		if err := d.db.QueryRowContext(ctx, query, tweet.Id, tweet.UserId).Scan(&exists); err != nil {
+			return app.Tweet{}, err
+		}
+		if exists && expected != 0 {
+			return app.Tweet{}, app.ErrVersionConflict
+		}
+		return app.Tweet{}, app.ErrTweetNotFound
+	}
 	if err != nil {
 		return app.Tweet{}, err
 	}
@@ -74,26 +157,708 @@ func (d Repository) UpdateTweetToDB(ctx context.Context, tweet app.Tweet) (app.T
 
 func (d Repository) DeleteTweetFromDB(ctx context.Context, tweet app.Tweet) error {
 	query := `delete from tweets where id = $1 and user_id = $2`
-	_, err := d.db.ExecContext(ctx, query, tweet.Id, tweet.UserId)
+	res, err := d.db.ExecContext(ctx, query, tweet.Id, tweet.UserId)
 	if err != nil {
 		return err
 	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	// чужой или несуществующий твит: событие удаления публиковать нельзя
+	if n == 0 {
+		return app.ErrTweetNotFound
+	}
 	return nil
 }
 
-func (d Repository) GetSubscribersTweetsFromDB(ctx context.Context, userIds []uuid.UUID) ([]app.Tweet, error) {
-	query := `select * from tweets where user_id =ANY ($1)`
-	row, err := d.db.QueryContext(ctx, query, pq.Array(userIds))
+// GetPollsFromDB возвращает опросы твитов вместе с результатами голосования. Твиты без опроса в ответ не попадают
+func (d Repository) GetPollsFromDB(ctx context.Context, tweetIds []uuid.UUID) (map[uuid.UUID]app.Poll, error) {
+	query := `select p.tweet_id, p.closes_at, o.position, o.text, o.votes
+	from polls p
+	join poll_options o on o.tweet_id = p.tweet_id
+	where p.tweet_id = ANY ($1)
+	order by p.tweet_id, o.position`
+	row, err := d.db.QueryContext(ctx, query, pq.Array(tweetIds))
+	if err != nil {
+		return nil, err
+	}
+	defer row.Close()
+
+	polls := make(map[uuid.UUID]app.Poll)
+	for row.Next() {
+		var (
+			tweetId  uuid.UUID
+			closesAt time.Time
+			option   app.PollOption
+		)
+		if err := row.Scan(&tweetId, &closesAt, &option.Position, &option.Text, &option.Votes); err != nil {
+			return nil, err
+		}
+		poll := polls[tweetId]
+		poll.TweetId = tweetId
+		poll.ClosesAt = closesAt
+		poll.Options = append(poll.Options, option)
+		polls[tweetId] = poll
+	}
+	return polls, row.Err()
+}
+
+// VotePollToDB сохраняет голос пользователя. Повторный голос и голос в закрытом опросе отклоняются
+func (d Repository) VotePollToDB(ctx context.Context, vote app.PollVote) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var closesAt time.Time
+	err = tx.QueryRowContext(ctx, `select closes_at from polls where tweet_id = $1 for update`, vote.TweetId).Scan(&closesAt)
 	if err != nil {
-		return []app.Tweet{}, err
+		return err
+	}
+	if !time.Now().UTC().Before(closesAt) {
+		return app.ErrPollClosed
+	}
+
+	res, err := tx.ExecContext(ctx, `update poll_options set votes = votes + 1 where tweet_id = $1 and position = $2`,
+		vote.TweetId, vote.Position)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return app.ErrPollOptionNotFound
+	}
+
+	res, err = tx.ExecContext(ctx, `insert into poll_votes (tweet_id, user_id, position) values ($1, $2, $3)
+	on conflict (tweet_id, user_id) do nothing`, vote.TweetId, vote.UserId, vote.Position)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return app.ErrAlreadyVoted
+	}
+
+	return tx.Commit()
+}
+
+func (d Repository) CreateMediaToDB(ctx context.Context, media app.Media) (app.Media, error) {
+	query := `insert into media (id, user_id, mime_type, size, width, height) values ($1, $2, $3, $4, $5, $6)
+	returning created_at`
+	err := d.db.QueryRowContext(ctx, query, media.Id, media.UserId, media.MimeType, media.Size,
+		media.Width, media.Height).Scan(&media.CreatedAt)
+	if err != nil {
+		return app.Media{}, err
+	}
+	return media, nil
+}
+
+func (d Repository) GetMediaByIDFromDB(ctx context.Context, id uuid.UUID) (app.Media, error) {
+	query := `select id, user_id, mime_type, size, width, height, created_at from media where id = $1`
+	var media app.Media
+	err := d.db.QueryRowContext(ctx, query, id).Scan(&media.Id, &media.UserId, &media.MimeType, &media.Size,
+		&media.Width, &media.Height, &media.CreatedAt)
+	if err != nil {
+		return app.Media{}, err
+	}
+	return media, nil
+}
+
+// GetMediaTweetIDFromDB возвращает твит, к которому прикреплен файл. Для неприкрепленного
+// файла возвращается sql.ErrNoRows
+func (d Repository) GetMediaTweetIDFromDB(ctx context.Context, mediaId uuid.UUID) (uuid.UUID, error) {
+	query := `select tweet_id from tweet_media where media_id = $1 limit 1`
+	var tweetId uuid.UUID
+	if err := d.db.QueryRowContext(ctx, query, mediaId).Scan(&tweetId); err != nil {
+		return uuid.Nil, err
+	}
+	return tweetId, nil
+}
+
+// GetTweetMediaFromDB возвращает вложения твитов в порядке их прикрепления
+func (d Repository) GetTweetMediaFromDB(ctx context.Context, tweetIds []uuid.UUID) (map[uuid.UUID][]app.Media, error) {
+	query := `select t.tweet_id, m.id, m.user_id, m.mime_type, m.size, m.width, m.height, m.created_at, t.alt_text
+	from tweet_media t
+	join media m on m.id = t.media_id
+	where t.tweet_id = ANY ($1)
+	order by t.tweet_id, t.position`
+	row, err := d.db.QueryContext(ctx, query, pq.Array(tweetIds))
+	if err != nil {
+		return nil, err
+	}
+	defer row.Close()
+
+	media := make(map[uuid.UUID][]app.Media)
+	for row.Next() {
+		var (
+			tweetId uuid.UUID
+			m       app.Media
+		)
+		err := row.Scan(&tweetId, &m.Id, &m.UserId, &m.MimeType, &m.Size, &m.Width, &m.Height, &m.CreatedAt, &m.AltText)
+		if err != nil {
+			return nil, err
+		}
+		media[tweetId] = append(media[tweetId], m)
+	}
+	return media, row.Err()
+}
+
+// GetTweetsByIDsFromDB возвращает твиты одним запросом. Порядок не гарантируется, отсутствующие id пропускаются
+func (d Repository) GetTweetsByIDsFromDB(ctx context.Context, ids []uuid.UUID) ([]app.Tweet, error) {
+	query := `select ` + tweetColumns + ` from tweets where id = ANY ($1)`
+	row, err := d.db.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return nil, err
 	}
 	defer row.Close()
 	var tweets []app.Tweet
 	for row.Next() {
-		var tweet app.Tweet
-		row.Scan(&tweet.Id, &tweet.Text,
-			&tweet.CreatedAt, &tweet.UpdatedAt, &tweet.UserId)
+		tweet, err := scanTweet(row)
+		if err != nil {
+			return nil, err
+		}
 		tweets = append(tweets, tweet)
 	}
-	return tweets, nil
+	return tweets, row.Err()
+}
+
+// AddBookmarkToDB сохраняет закладку. Повторное добавление переносит закладку в указанную папку
+func (d Repository) AddBookmarkToDB(ctx context.Context, bookmark app.Bookmark) error {
+	query := `insert into bookmarks (user_id, tweet_id, folder_id)
+	select $1, $2, $3
+	where $3::uuid is null or exists (select 1 from bookmark_folders where id = $3 and user_id = $1)
+	on conflict (user_id, tweet_id) do update set folder_id = excluded.folder_id`
+	res, err := d.db.ExecContext(ctx, query, bookmark.UserId, bookmark.TweetId, bookmark.FolderId)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return app.ErrFolderNotFound
+	}
+	return nil
+}
+
+func (d Repository) DeleteBookmarkFromDB(ctx context.Context, bookmark app.Bookmark) error {
+	query := `delete from bookmarks where user_id = $1 and tweet_id = $2`
+	_, err := d.db.ExecContext(ctx, query, bookmark.UserId, bookmark.TweetId)
+	return err
+}
+
+// DeleteBookmarksByTweetFromDB удаляет закладки всех пользователей на удаленный твит
+func (d Repository) DeleteBookmarksByTweetFromDB(ctx context.Context, tweetId uuid.UUID) error {
+	_, err := d.db.ExecContext(ctx, `delete from bookmarks where tweet_id = $1`, tweetId)
+	return err
+}
+
+// GetBookmarksFromDB возвращает страницу закладок от новых к старым, начиная после cursor
+func (d Repository) GetBookmarksFromDB(ctx context.Context, userId uuid.UUID, folderId uuid.NullUUID,
+	cursor *app.PageCursor, limit int) ([]app.Bookmark, error) {
+	var (
+		after   sql.NullTime
+		afterId uuid.NullUUID
+	)
+	if cursor != nil {
+		after = sql.NullTime{Time: cursor.CreatedAt, Valid: true}
+		afterId = uuid.NullUUID{UUID: cursor.TweetId, Valid: true}
+	}
+	query := `select user_id, tweet_id, folder_id, created_at from bookmarks
+	where user_id = $1
+	and ($2::uuid is null or folder_id = $2)
+	and ($3::timestamp is null or (created_at, tweet_id) < ($3, $4::uuid))
+	order by created_at desc, tweet_id desc
+	limit $5`
+	row, err := d.db.QueryContext(ctx, query, userId, folderId, after, afterId, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer row.Close()
+	var bookmarks []app.Bookmark
+	for row.Next() {
+		var b app.Bookmark
+		if err := row.Scan(&b.UserId, &b.TweetId, &b.FolderId, &b.CreatedAt); err != nil {
+			return nil, err
+		}
+		bookmarks = append(bookmarks, b)
+	}
+	return bookmarks, row.Err()
+}
+
+// GetBookmarkedTweetIDsFromDB возвращает id твитов из tweetIds, которые пользователь добавил в закладки
+func (d Repository) GetBookmarkedTweetIDsFromDB(ctx context.Context, userId uuid.UUID, tweetIds []uuid.UUID) (map[uuid.UUID]bool, error) {
+	query := `select tweet_id from bookmarks where user_id = $1 and tweet_id = ANY ($2)`
+	row, err := d.db.QueryContext(ctx, query, userId, pq.Array(tweetIds))
+	if err != nil {
+		return nil, err
+	}
+	defer row.Close()
+	bookmarked := make(map[uuid.UUID]bool)
+	for row.Next() {
+		var id uuid.UUID
+		if err := row.Scan(&id); err != nil {
+			return nil, err
+		}
+		bookmarked[id] = true
+	}
+	return bookmarked, row.Err()
+}
+
+func (d Repository) CreateBookmarkFolderToDB(ctx context.Context, folder app.BookmarkFolder) (app.BookmarkFolder, error) {
+	query := `insert into bookmark_folders (user_id, name) values ($1, $2) returning id, user_id, name, created_at`
+	err := d.db.QueryRowContext(ctx, query, folder.UserId, folder.Name).Scan(&folder.Id, &folder.UserId,
+		&folder.Name, &folder.CreatedAt)
+	if isUniqueViolation(err) {
+		return app.BookmarkFolder{}, app.ErrFolderExists
+	}
+	if err != nil {
+		return app.BookmarkFolder{}, err
+	}
+	return folder, nil
+}
+
+func (d Repository) GetBookmarkFoldersFromDB(ctx context.Context, userId uuid.UUID) ([]app.BookmarkFolder, error) {
+	query := `select id, user_id, name, created_at from bookmark_folders where user_id = $1 order by created_at`
+	row, err := d.db.QueryContext(ctx, query, userId)
+	if err != nil {
+		return nil, err
+	}
+	defer row.Close()
+	var folders []app.BookmarkFolder
+	for row.Next() {
+		var f app.BookmarkFolder
+		if err := row.Scan(&f.Id, &f.UserId, &f.Name, &f.CreatedAt); err != nil {
+			return nil, err
+		}
+		folders = append(folders, f)
+	}
+	return folders, row.Err()
+}
+
+func (d Repository) RenameBookmarkFolderToDB(ctx context.Context, folder app.BookmarkFolder) (app.BookmarkFolder, error) {
+	query := `update bookmark_folders set name = $1 where id = $2 and user_id = $3
+	returning id, user_id, name, created_at`
+	err := d.db.QueryRowContext(ctx, query, folder.Name, folder.Id, folder.UserId).Scan(&folder.Id, &folder.UserId,
+		&folder.Name, &folder.CreatedAt)
+	if err == sql.ErrNoRows {
+		return app.BookmarkFolder{}, app.ErrFolderNotFound
+	}
+	if isUniqueViolation(err) {
+		return app.BookmarkFolder{}, app.ErrFolderExists
+	}
+	if err != nil {
+		return app.BookmarkFolder{}, err
+	}
+	return folder, nil
+}
+
+// DeleteBookmarkFolderFromDB удаляет папку, закладки из нее остаются без папки
+func (d Repository) DeleteBookmarkFolderFromDB(ctx context.Context, folder app.BookmarkFolder) error {
+	res, err := d.db.ExecContext(ctx, `delete from bookmark_folders where id = $1 and user_id = $2`, folder.Id, folder.UserId)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return app.ErrFolderNotFound
+	}
+	return nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
+
+// GetTweetsByUsersFromDB возвращает страницу твитов авторов от новых к старым, начиная после cursor
+func (d Repository) GetTweetsByUsersFromDB(ctx context.Context, userIds []uuid.UUID, cursor *app.PageCursor,
+	limit int) ([]app.Tweet, error) {
+	var (
+		after   sql.NullTime
+		afterId uuid.NullUUID
+	)
+	if cursor != nil {
+		after = sql.NullTime{Time: cursor.CreatedAt, Valid: true}
+		afterId = uuid.NullUUID{UUID: cursor.TweetId, Valid: true}
+	}
+	query := `select ` + tweetColumns + ` from tweets
+	where user_id = ANY ($1)
+	and ($2::timestamp is null or (created_at, id) < ($2, $3::uuid))
+	order by created_at desc, id desc
+	limit $4`
+	row, err := d.db.QueryContext(ctx, query, pq.Array(userIds), after, afterId, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer row.Close()
+	var tweets []app.Tweet
+	for row.Next() {
+		tweet, err := scanTweet(row)
+		if err != nil {
+			return nil, err
+		}
+		tweets = append(tweets, tweet)
+	}
+	return tweets, row.Err()
+}
+
+const listColumns = `l.id, l.owner_id, l.name, l.description, l.private, l.created_at, l.updated_at,
+	(select count(*) from list_members m where m.list_id = l.id)`
+
+func scanList(row interface{ Scan(...any) error }) (app.List, error) {
+	var l app.List
+	err := row.Scan(&l.Id, &l.OwnerId, &l.Name, &l.Description, &l.Private, &l.CreatedAt, &l.UpdatedAt, &l.MemberCount)
+	return l, err
+}
+
+func (d Repository) CreateListToDB(ctx context.Context, list app.List) (app.List, error) {
+	query := `insert into lists (owner_id, name, description, private) values ($1, $2, $3, $4)
+	returning id, created_at, updated_at`
+	err := d.db.QueryRowContext(ctx, query, list.OwnerId, list.Name, list.Description, list.Private).Scan(&list.Id,
+		&list.CreatedAt, &list.UpdatedAt)
+	if err != nil {
+		return app.List{}, err
+	}
+	return list, nil
+}
+
+func (d Repository) GetListByIDFromDB(ctx context.Context, id uuid.UUID) (app.List, error) {
+	query := `select ` + listColumns + ` from lists l where l.id = $1`
+	list, err := scanList(d.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return app.List{}, app.ErrListNotFound
+	}
+	if err != nil {
+		return app.List{}, err
+	}
+	return list, nil
+}
+
+// GetUserListsFromDB возвращает списки владельца. Приватные попадают в ответ только при includePrivate
+func (d Repository) GetUserListsFromDB(ctx context.Context, ownerId uuid.UUID, includePrivate bool) ([]app.List, error) {
+	query := `select ` + listColumns + ` from lists l
+	where l.owner_id = $1 and ($2 or not l.private)
+	order by l.created_at`
+	row, err := d.db.QueryContext(ctx, query, ownerId, includePrivate)
+	if err != nil {
+		return nil, err
+	}
+	defer row.Close()
+	var lists []app.List
+	for row.Next() {
+		list, err := scanList(row)
+		if err != nil {
+			return nil, err
+		}
+		lists = append(lists, list)
+	}
+	return lists, row.Err()
+}
+
+func (d Repository) UpdateListToDB(ctx context.Context, list app.List) (app.List, error) {
+	query := `update lists l
+	set name = $1, description = $2, private = $3, updated_at = now()
+	where l.id = $4 and l.owner_id = $5
+	returning ` + listColumns
+	updated, err := scanList(d.db.QueryRowContext(ctx, query, list.Name, list.Description, list.Private, list.Id, list.OwnerId))
+	if err == sql.ErrNoRows {
+		return app.List{}, app.ErrListNotFound
+	}
+	if err != nil {
+		return app.List{}, err
+	}
+	return updated, nil
+}
+
+func (d Repository) DeleteListFromDB(ctx context.Context, list app.List) error {
+	res, err := d.db.ExecContext(ctx, `delete from lists where id = $1 and owner_id = $2`, list.Id, list.OwnerId)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return app.ErrListNotFound
+	}
+	return nil
+}
+
+// AddListMemberToDB добавляет участника в список владельца с учетом лимита app.MaxListMembers
+func (d Repository) AddListMemberToDB(ctx context.Context, list app.List, userId uuid.UUID) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// блокировка строки списка сериализует параллельные добавления
+	var id uuid.UUID
+	err = tx.QueryRowContext(ctx, `select id from lists where id = $1 and owner_id = $2 for update`,
+		list.Id, list.OwnerId).Scan(&id)
+	if err == sql.ErrNoRows {
+		return app.ErrListNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	var count int
+	err = tx.QueryRowContext(ctx, `select count(*) from list_members where list_id = $1`, list.Id).Scan(&count)
+	if err != nil {
+		return err
+	}
+	if count >= app.MaxListMembers {
+		return app.ErrListFull
+	}
+
+	_, err = tx.ExecContext(ctx, `insert into list_members (list_id, user_id) values ($1, $2)
+	on conflict (list_id, user_id) do nothing`, list.Id, userId)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `update lists set updated_at = now() where id = $1`, list.Id)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (d Repository) DeleteListMemberFromDB(ctx context.Context, list app.List, userId uuid.UUID) error {
+	query := `delete from list_members m
+	using lists l
+	where l.id = m.list_id and m.list_id = $1 and l.owner_id = $2 and m.user_id = $3`
+	_, err := d.db.ExecContext(ctx, query, list.Id, list.OwnerId, userId)
+	return err
+}
+
+func (d Repository) GetListMembersFromDB(ctx context.Context, listId uuid.UUID) ([]uuid.UUID, error) {
+	query := `select user_id from list_members where list_id = $1 order by created_at`
+	row, err := d.db.QueryContext(ctx, query, listId)
+	if err != nil {
+		return nil, err
+	}
+	defer row.Close()
+	var members []uuid.UUID
+	for row.Next() {
+		var id uuid.UUID
+		if err := row.Scan(&id); err != nil {
+			return nil, err
+		}
+		members = append(members, id)
+	}
+	return members, row.Err()
+}
+
+func (d Repository) BlockUserToDB(ctx context.Context, userId uuid.UUID, targetId uuid.UUID) error {
+	query := `insert into blocks (user_id, target_id) values ($1, $2) on conflict do nothing`
+	_, err := d.db.ExecContext(ctx, query, userId, targetId)
+	return err
+}
+
+func (d Repository) UnblockUserFromDB(ctx context.Context, userId uuid.UUID, targetId uuid.UUID) error {
+	_, err := d.db.ExecContext(ctx, `delete from blocks where user_id = $1 and target_id = $2`, userId, targetId)
+	return err
+}
+
+func (d Repository) MuteUserToDB(ctx context.Context, userId uuid.UUID, targetId uuid.UUID) error {
+	query := `insert into mutes (user_id, target_id) values ($1, $2) on conflict do nothing`
+	_, err := d.db.ExecContext(ctx, query, userId, targetId)
+	return err
+}
+
+func (d Repository) UnmuteUserFromDB(ctx context.Context, userId uuid.UUID, targetId uuid.UUID) error {
+	_, err := d.db.ExecContext(ctx, `delete from mutes where user_id = $1 and target_id = $2`, userId, targetId)
+	return err
+}
+
+// GetHiddenAuthorsFromDB возвращает авторов из authorIds, чьи твиты читатель видеть не должен:
+// заблокированных или скрытых читателем, заблокировавших читателя и защищенных аккаунтов,
+// которые не одобрили читателю доступ
+func (d Repository) GetHiddenAuthorsFromDB(ctx context.Context, viewerId uuid.UUID, authorIds []uuid.UUID) (map[uuid.UUID]bool, error) {
+	query := `select target_id from blocks where user_id = $1 and target_id = ANY ($2)
+	union
+	select user_id from blocks where target_id = $1 and user_id = ANY ($2)
+	union
+	select target_id from mutes where user_id = $1 and target_id = ANY ($2)
+	union
+	select p.user_id from protected_accounts p
+	where p.user_id = ANY ($2) and not exists (
+		select 1 from account_access a
+		where a.owner_id = p.user_id and a.reader_id = $1 and a.status = 'approved'
+	)`
+	row, err := d.db.QueryContext(ctx, query, viewerId, pq.Array(authorIds))
+	if err != nil {
+		return nil, err
+	}
+	defer row.Close()
+	hidden := make(map[uuid.UUID]bool)
+	for row.Next() {
+		var id uuid.UUID
+		if err := row.Scan(&id); err != nil {
+			return nil, err
+		}
+		hidden[id] = true
+	}
+	return hidden, row.Err()
+}
+
+func (d Repository) SetProtectedToDB(ctx context.Context, userId uuid.UUID, protected bool) error {
+	query := `delete from protected_accounts where user_id = $1`
+	if protected {
+		query = `insert into protected_accounts (user_id) values ($1) on conflict do nothing`
+	}
+	_, err := d.db.ExecContext(ctx, query, userId)
+	return err
+}
+
+func (d Repository) IsProtectedFromDB(ctx context.Context, userId uuid.UUID) (bool, error) {
+	var protected bool
+	query := `select exists (select 1 from protected_accounts where user_id = $1)`
+	err := d.db.QueryRowContext(ctx, query, userId).Scan(&protected)
+	return protected, err
+}
+
+// RequestAccessToDB создает запрос на доступ. Для существующего запроса возвращается его текущий статус
+func (d Repository) RequestAccessToDB(ctx context.Context, request app.AccessRequest) (app.AccessRequest, error) {
+	query := `with ins as (
+		insert into account_access (owner_id, reader_id, status) values ($1, $2, 'pending')
+		on conflict (owner_id, reader_id) do nothing
+		returning status, created_at
+	)
+	select status, created_at from ins
+	union all
+	select status, created_at from account_access where owner_id = $1 and reader_id = $2
+	limit 1`
+	err := d.db.QueryRowContext(ctx, query, request.OwnerId, request.ReaderId).Scan(&request.Status, &request.CreatedAt)
+	if err != nil {
+		return app.AccessRequest{}, err
+	}
+	return request, nil
+}
+
+func (d Repository) GetAccessRequestsFromDB(ctx context.Context, ownerId uuid.UUID) ([]app.AccessRequest, error) {
+	query := `select owner_id, reader_id, status, created_at from account_access
+	where owner_id = $1 and status = 'pending'
+	order by created_at`
+	row, err := d.db.QueryContext(ctx, query, ownerId)
+	if err != nil {
+		return nil, err
+	}
+	defer row.Close()
+	var requests []app.AccessRequest
+	for row.Next() {
+		var r app.AccessRequest
+		if err := row.Scan(&r.OwnerId, &r.ReaderId, &r.Status, &r.CreatedAt); err != nil {
+			return nil, err
+		}
+		requests = append(requests, r)
+	}
+	return requests, row.Err()
+}
+
+func (d Repository) ApproveAccessRequestToDB(ctx context.Context, ownerId uuid.UUID, readerId uuid.UUID) error {
+	query := `update account_access set status = 'approved', updated_at = now()
+	where owner_id = $1 and reader_id = $2 and status = 'pending'`
+	res, err := d.db.ExecContext(ctx, query, ownerId, readerId)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return app.ErrAccessNotFound
+	}
+	return nil
+}
+
+// RevokeAccessFromDB отзывает одобренный доступ или отклоняет ожидающий запрос
+func (d Repository) RevokeAccessFromDB(ctx context.Context, ownerId uuid.UUID, readerId uuid.UUID) error {
+	query := `delete from account_access where owner_id = $1 and reader_id = $2`
+	_, err := d.db.ExecContext(ctx, query, ownerId, readerId)
+	return err
+}
+
+const profileColumns = `id, username, display_name, bio, avatar_url, location, website, created_at, updated_at`
+
+func scanProfile(row interface{ Scan(...any) error }) (app.Profile, error) {
+	var p app.Profile
+	err := row.Scan(&p.UserId, &p.Username, &p.DisplayName, &p.Bio, &p.AvatarURL, &p.Location, &p.Website,
+		&p.CreatedAt, &p.UpdatedAt)
+	return p, err
+}
+
+func (d Repository) GetProfileFromDB(ctx context.Context, userId uuid.UUID) (app.Profile, error) {
+	query := `select ` + profileColumns + ` from users where id = $1`
+	profile, err := scanProfile(d.db.QueryRowContext(ctx, query, userId))
+	if err == sql.ErrNoRows {
+		return app.Profile{}, app.ErrProfileNotFound
+	}
+	if err != nil {
+		return app.Profile{}, err
+	}
+	return profile, nil
+}
+
+// GetProfileByUsernameFromDB ищет профиль по имени без учета регистра
+func (d Repository) GetProfileByUsernameFromDB(ctx context.Context, username string) (app.Profile, error) {
+	query := `select ` + profileColumns + ` from users where lower(username) = lower($1)`
+	profile, err := scanProfile(d.db.QueryRowContext(ctx, query, username))
+	if err == sql.ErrNoRows {
+		return app.Profile{}, app.ErrProfileNotFound
+	}
+	if err != nil {
+		return app.Profile{}, err
+	}
+	return profile, nil
+}
+
+// GetProfilesByIDsFromDB возвращает профили авторов. Пользователи без профиля в ответ не попадают
+func (d Repository) GetProfilesByIDsFromDB(ctx context.Context, userIds []uuid.UUID) (map[uuid.UUID]app.Profile, error) {
+	query := `select ` + profileColumns + ` from users where id = ANY ($1)`
+	row, err := d.db.QueryContext(ctx, query, pq.Array(userIds))
+	if err != nil {
+		return nil, err
+	}
+	defer row.Close()
+	profiles := make(map[uuid.UUID]app.Profile)
+	for row.Next() {
+		profile, err := scanProfile(row)
+		if err != nil {
+			return nil, err
+		}
+		profiles[profile.UserId] = profile
+	}
+	return profiles, row.Err()
+}
+
+// UpsertProfileToDB создает профиль при первом сохранении и полностью перезаписывает его при следующих
+func (d Repository) UpsertProfileToDB(ctx context.Context, profile app.Profile) (app.Profile, error) {
+	query := `insert into users (id, username, display_name, bio, avatar_url, location, website)
+	values ($1, $2, $3, $4, $5, $6, $7)
+	on conflict (id) do update set username = excluded.username, display_name = excluded.display_name,
+	bio = excluded.bio, avatar_url = excluded.avatar_url, location = excluded.location,
+	website = excluded.website, updated_at = now()
+	returning ` + profileColumns
+	saved, err := scanProfile(d.db.QueryRowContext(ctx, query, profile.UserId, profile.Username, profile.DisplayName,
+		profile.Bio, profile.AvatarURL, profile.Location, profile.Website))
+	if isUniqueViolation(err) {
+		return app.Profile{}, app.ErrUsernameTaken
+	}
+	if err != nil {
+		return app.Profile{}, err
+	}
+	return saved, nil
 }
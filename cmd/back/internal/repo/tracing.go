@@ -0,0 +1,198 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"runtime"
+	"strings"
+	"twitter/internal/breaker"
+
+	"github.com/lib/pq"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("twitter/cmd/back/internal/repo")
+
+// tracedDB пишет спан на каждый запрос. Имя спана - метод Repository, который выполняет запрос.
+// Запросы проходят через breaker, и каждый ограничен его дедлайном
+type tracedDB struct {
+	*sql.DB
+	breaker *breaker.Breaker
+}
+
+// BeginTx открывает транзакцию. Контекст транзакции - контекст запроса: дедлайн breaker
+// получает каждый запрос внутри нее
+func (db tracedDB) BeginTx(ctx context.Context, opts *sql.TxOptions) (tracedTx, error) {
+	done, err := db.breaker.Allow()
+	if err != nil {
+		return tracedTx{}, err
+	}
+	tx, err := db.DB.BeginTx(ctx, opts)
+	done(err)
+	return tracedTx{Tx: tx, breaker: db.breaker}, err
+}
+
+// QueryContext возвращает строки, спан которых завершается в Close: чтение строк входит в запрос
+func (db tracedDB) QueryContext(ctx context.Context, query string, args ...any) (*tracedRows, error) {
+	ctx, span := startQuerySpan(ctx, query)
+	done, err := db.breaker.Allow()
+	if err != nil {
+		endQuerySpan(span, err)
+		return nil, err
+	}
+	ctx, cancel := db.breaker.WithTimeout(ctx)
+	rows, err := db.DB.QueryContext(ctx, query, args...)
+	done(err)
+	if err != nil {
+		cancel()
+		endQuerySpan(span, err)
+		return nil, err
+	}
+	return &tracedRows{Rows: rows, span: span, cancel: cancel}, nil
+}
+
+// tracedRows завершает спан запроса и освобождает его дедлайн при закрытии строк
+type tracedRows struct {
+	*sql.Rows
+	span   trace.Span
+	cancel context.CancelFunc
+	closed bool
+}
+
+func (r *tracedRows) Close() error {
+	err := r.Rows.Close()
+	r.cancel()
+	if !r.closed {
+		r.closed = true
+		endQuerySpan(r.span, errors.Join(r.Rows.Err(), err))
+	}
+	return err
+}
+
+func (db tracedDB) QueryRowContext(ctx context.Context, query string, args ...any) tracedRow {
+	ctx, span := startQuerySpan(ctx, query)
+	done, err := db.breaker.Allow()
+	if err != nil {
+		endQuerySpan(span, err)
+		return tracedRow{err: err}
+	}
+	ctx, cancel := db.breaker.WithTimeout(ctx)
+	row := db.DB.QueryRowContext(ctx, query, args...)
+	done(row.Err())
+	endQuerySpan(span, row.Err())
+	return tracedRow{Row: row, cancel: cancel}
+}
+
+// tracedRow строка результата или ошибка breaker, которую *sql.Row передать не может.
+// Строка читается после возврата из обертки, поэтому дедлайн запроса освобождает Scan
+type tracedRow struct {
+	*sql.Row
+	err    error
+	cancel context.CancelFunc
+}
+
+func (r tracedRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	defer r.cancel()
+	return r.Row.Scan(dest...)
+}
+
+func (r tracedRow) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.Row.Err()
+}
+
+func (db tracedDB) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	ctx, span := startQuerySpan(ctx, query)
+	done, err := db.breaker.Allow()
+	if err != nil {
+		endQuerySpan(span, err)
+		return nil, err
+	}
+	ctx, cancel := db.breaker.WithTimeout(ctx)
+	defer cancel()
+	res, err := db.DB.ExecContext(ctx, query, args...)
+	done(err)
+	endQuerySpan(span, err)
+	return res, err
+}
+
+// IsFailure отличает недоступность Postgres от ошибок запроса: отсутствующей строки,
+// нарушения ограничений и подобных
+func IsFailure(err error) bool {
+	if err == nil || errors.Is(err, sql.ErrNoRows) || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code.Class() {
+		// connection exception, insufficient resources, operator intervention, system error
+		case "08", "53", "57", "58":
+			return true
+		}
+		return false
+	}
+	return true
+}
+
+// tracedTx то же для запросов внутри транзакции. Breaker уже пропустил транзакцию,
+// от него берется только дедлайн
+type tracedTx struct {
+	*sql.Tx
+	breaker *breaker.Breaker
+}
+
+func (tx tracedTx) QueryRowContext(ctx context.Context, query string, args ...any) tracedRow {
+	ctx, span := startQuerySpan(ctx, query)
+	ctx, cancel := tx.breaker.WithTimeout(ctx)
+	row := tx.Tx.QueryRowContext(ctx, query, args...)
+	endQuerySpan(span, row.Err())
+	return tracedRow{Row: row, cancel: cancel}
+}
+
+func (tx tracedTx) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	ctx, span := startQuerySpan(ctx, query)
+	ctx, cancel := tx.breaker.WithTimeout(ctx)
+	defer cancel()
+	res, err := tx.Tx.ExecContext(ctx, query, args...)
+	endQuerySpan(span, err)
+	return res, err
+}
+
+// startQuerySpan вызывается из методов-оберток, поэтому метод Repository, выполняющий
+// запрос, находится на три кадра выше callerName
+func startQuerySpan(ctx context.Context, query string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "repo."+callerName(3),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system.name", "postgresql"),
+			attribute.String("db.query.text", query),
+		),
+	)
+}
+
+func endQuerySpan(span trace.Span, err error) {
+	if err != nil && err != sql.ErrNoRows {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func callerName(skip int) string {
+	pc, _, _, ok := runtime.Caller(skip)
+	if !ok {
+		return "query"
+	}
+	name := runtime.FuncForPC(pc).Name()
+	return name[strings.LastIndex(name, ".")+1:]
+}
@@ -3,27 +3,42 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
 	"log"
 	"log/slog"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 	"twitter/cmd/back/internal/api"
+	"twitter/cmd/back/internal/blob"
 	"twitter/cmd/back/internal/cache"
+	"twitter/cmd/back/internal/config"
+	"twitter/cmd/back/internal/consumer"
+	"twitter/cmd/back/internal/health"
 	"twitter/cmd/back/internal/producer"
 	"twitter/cmd/back/internal/repo"
+	"twitter/internal/breaker"
+	"twitter/internal/limiter"
 	"twitter/internal/logger"
 	"twitter/internal/metrics"
 	"twitter/internal/rabbitmq"
+	"twitter/internal/tracing"
 
 	pb "twitter/api/proto/v1"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	grpc_run "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
@@ -31,112 +46,196 @@ import (
 	_ "github.com/lib/pq"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	grpc_health "google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
 	"gopkg.in/yaml.v3"
 )
 
-type Config struct {
-	DSN               string        `yaml:"dsn"`
-	Host              string        `yaml:"host"`
-	HostGRPC          string        `yaml:"host_grpc"`
-	MigrateDir        string        `yaml:"migrate_dir"`
-	Driver            string        `yaml:"driver"`
-	LogLevel          int           `yaml:"loglevel"`
-	TimeOut           time.Duration `yaml:"timeout"`
-	TokenJwtTTl       time.Duration `yaml:"token_jwt_ttl"`
-	JwtSecret         string        `yaml:"jwt_secret"`
-	AddrCache         string        `yaml:"addr_cache"`
-	PasswordCache     string        `yaml:"password_cache"`
-	DBCacheTweet      int           `yaml:"db_cache_tweet"`
-	DBCacheUserTweets int           `yaml:"db_cache_user_tweets"`
-	HostRBMQ          string        `yaml:"host_rbmq"`
-	PortRBMQ          string        `yaml:"port_rbmq"`
-	UserNameRBMQ      string        `yaml:"username_rbmq"`
-	PasswordRBMQ      string        `yaml:"password_rbmq"`
-	VHostRBMQ         string        `yaml:"vhost_rbmq"`
-}
+const (
+	// readinessTimeout время на проверку одной зависимости
+	readinessTimeout = 2 * time.Second
+	// healthInterval период обновления статуса grpc.health.v1
+	healthInterval = 5 * time.Second
+	// shutdownTimeout время на завершение запросов в работе и отправку сообщений при остановке
+	shutdownTimeout = 15 * time.Second
+	// serviceName имя сервиса в трассировках
+	serviceName = "twitter-back"
+	// tweetInvalidationChannel канал Redis pub/sub с ключами твитов, измененных другой репликой
+	tweetInvalidationChannel = "tweets:invalidate"
+)
 
 func main() {
 
-	yamlConfig, err := os.ReadFile("./config.yaml")
-	if err != nil {
-		log.Fatal(err)
+	configPath := flag.String("config", "./config.yaml", "path to the YAML config file")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [--config path] [config print [--redact]]\n", os.Args[0])
+		flag.PrintDefaults()
 	}
+	flag.Parse()
 
-	var cfg Config
-	err = yaml.Unmarshal(yamlConfig, &cfg)
+	if flag.NArg() > 0 {
+		if err := runCommand(*configPath, flag.Args()); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	cfg, err := config.Load(*configPath)
 	if err != nil {
 		log.Fatal(err)
 	}
+	// SIGHUP по умолчанию завершает процесс, поэтому он перехватывается до долгого запуска:
+	// сигнал, пришедший раньше, применится, когда заработает перезагрузка
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
 
 	rabbit, err := rabbitmq.NewRabbitMQClient(cfg.HostRBMQ, cfg.PortRBMQ, cfg.UserNameRBMQ, cfg.PasswordRBMQ, cfg.VHostRBMQ)
 	if err != nil {
 		log.Fatal(err)
 	}
-	defer rabbit.Close()
 
-	log := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
-		Level: slog.Level(cfg.LogLevel),
-	}))
+	// уровень логирования меняется при перезагрузке конфига
+	logLevel := new(slog.LevelVar)
+	logLevel.Set(slog.Level(cfg.LogLevel))
+	log, err := logger.New(os.Stdout, cfg.LogFormat, logLevel)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	// компоненты без логгера в контексте пишут через slog.Default
+	slog.SetDefault(log)
 
 	ctxParent := logger.NewContext(context.Background(), log)
 
-	ctx, cancel := signal.NotifyContext(ctxParent, os.Interrupt, syscall.SIGHUP, syscall.SIGINT, syscall.SIGQUIT, syscall.SIGABRT, syscall.SIGTERM)
+	ctx, cancel := signal.NotifyContext(ctxParent, os.Interrupt, syscall.SIGINT, syscall.SIGQUIT, syscall.SIGABRT, syscall.SIGTERM)
 	defer cancel()
 	go forceShutdown(ctx)
 
-	producer := producer.NewProducer(rabbit.Ch)
+	shutdownTracing, err := tracing.Setup(ctx, tracing.Config{
+		ServiceName: serviceName,
+		Exporter:    cfg.TraceExporter,
+		Endpoint:    cfg.TraceEndpoint,
+		File:        cfg.TraceFile,
+		SampleRatio: cfg.TraceSampleRatio,
+	})
+	if err != nil {
+		log.Error("tracing setup failed", "error", err)
+		os.Exit(1)
+	}
+
+	configStore := config.NewStore(*configPath, cfg)
+	settings := api.NewSettings(runtimeSettings(configStore.Current()))
+	configStore.Subscribe(func(snapshot config.Snapshot) {
+		logLevel.Set(slog.Level(snapshot.Config.LogLevel))
+		settings.Update(runtimeSettings(snapshot))
+	})
+
+	producer := producer.NewProducer(rabbit, producer.Options{
+		Breaker:   breaker.New("rabbitmq", breakerConfig(cfg, cfg.TimeOutRBMQ), nil),
+		Fallback:  cfg.FallbackRBMQ,
+		QueueSize: cfg.QueueSizeRBMQ,
+	})
+
+	// фоновые горутины, которые завершаются по отмене ctx; остановка ждет их
+	var workers sync.WaitGroup
 
 	migrator, err := migrate.New(cfg.MigrateDir, cfg.DSN)
 	if err != nil {
-		// fmt.Println(err)
-		log.Error(err.Error())
+		log.Error("migrate init failed", "error", err)
+		os.Exit(1)
 	}
 
 	if err := migrator.Up(); err != nil && err != migrate.ErrNoChange {
-		// fmt.Println(err)
-		log.Error(err.Error())
+		log.Error("migrate up failed", "error", err)
+		os.Exit(1)
 	}
 
 	rowSQLConn, err := sql.Open(cfg.Driver, cfg.DSN)
 	if err != nil {
-		// fmt.Println(err)
-		log.Error(err.Error())
+		log.Error("database open failed", "error", err)
+		os.Exit(1)
 	}
 
-	repo := repo.NewRepository(rowSQLConn)
+	repo := repo.NewRepository(rowSQLConn, breaker.New("postgres", breakerConfig(cfg, cfg.TimeOutDB), repo.IsFailure))
 
-	redisClientTweets := cache.NewRedisClient(cfg.AddrCache, cfg.PasswordCache, cfg.DBCacheTweet)
+	// переподключение к брокеру после разрыва соединения
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		rabbit.Run(ctx)
+	}()
 
-	if err := redisClientTweets.Connect(ctx); err != nil {
-		log.Error("RedisTweet - not connected")
-	} else {
-		log.Warn("RedisTweet - connected")
-	}
+	// повторная публикация событий, отложенных при недоступном брокере
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		producer.RunRetries(ctx)
+	}()
 
-	defer redisClientTweets.Close()
+	// потребитель читает очередь на своем канале, чтобы не делить канал с публикацией
+	bookmarkCleaner := consumer.NewBookmarkCleaner(rabbit, repo)
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		bookmarkCleaner.Run(ctx)
+	}()
 
-	redisClientUserTweets := cache.NewRedisClient(cfg.AddrCache, cfg.PasswordCache, cfg.DBCacheUserTweets)
+	redisClient, err := cache.NewRedisClient(cache.Options{
+		Topology:     cfg.CacheTopology,
+		Addrs:        cfg.CacheAddrs(),
+		MasterName:   cfg.CacheMasterName,
+		Username:     cfg.UserNameCache,
+		Password:     cfg.PasswordCache,
+		KeyPrefix:    cfg.CacheKeyPrefix,
+		PoolSize:     cfg.CachePoolSize,
+		MinIdleConns: cfg.CacheMinIdleConns,
+		DialTimeout:  cfg.CacheDialTimeout,
+		ReadTimeout:  cfg.CacheReadTimeout,
+		WriteTimeout: cfg.CacheWriteTimeout,
+		PoolTimeout:  cfg.CachePoolTimeout,
+		Breaker:      breaker.New("redis", breakerConfig(cfg, cfg.TimeOutCache), cache.IsFailure),
+	})
+	if err != nil {
+		log.Error("redis client", "error", err)
+		os.Exit(1)
+	}
 
-	if err := redisClientUserTweets.Connect(ctx); err != nil {
-		log.Error("RedisUserTweets - not connected")
+	if err := redisClient.Connect(ctx); err != nil {
+		log.Error("Redis - not connected")
 	} else {
-		log.Warn("RedisUserTweets - connected")
+		log.Warn("Redis - connected")
 	}
 
-	defer redisClientUserTweets.Close()
+	// твиты читаются из памяти процесса, изменения рассылаются остальным репликам через pub/sub
+	tweetCache := cache.NewTieredCache("tweets",
+		cache.NewLocalCache(*cfg.LocalCacheSize, cfg.LocalCacheTTL), redisClient, tweetInvalidationChannel)
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		if err := tweetCache.ListenInvalidations(ctx); err != nil {
+			log.Error("cache invalidation listener stopped", "error", err)
+		}
+	}()
 
 	twitterGrpcServer := api.GrpcServer{
 		Database:          repo,
-		JwtSecret:         cfg.JwtSecret,
-		CacheDBTweets:     redisClientTweets,
-		CacheDBUserTweets: redisClientUserTweets,
+		Settings:          settings,
+		CacheDBTweets:     tweetCache,
+		CacheDBUserTweets: redisClient,
+		CacheDBPolls:      redisClient,
 		Producer:          producer,
+		BlobStore:         blob.NewFileStore(cfg.MediaDir),
+		TweetLoads:        new(singleflight.Group),
+		TweetCodec:        cache.TweetCodec{CompressMinSize: cfg.CacheCompressMinSize},
+		CacheFallback:     cfg.FallbackCache,
 	}
 	ln, err := net.Listen("tcp", cfg.HostGRPC)
 	if err != nil {
-		// fmt.Println(err)
-		log.Error(err.Error())
+		log.Error("grpc listen failed", "error", err)
+		os.Exit(1)
 	}
 
 	loggingOpts := []logging.Option{
@@ -147,63 +246,251 @@ func main() {
 			logging.PayloadSent,     // --
 		),
 	}
+	// в потоках тела не пишутся: каждый кусок UploadMedia попал бы в лог целиком
+	streamLoggingOpts := []logging.Option{
+		logging.WithLogOnEvents(logging.StartCall, logging.FinishCall),
+	}
+
+	publicMethods := api.NewPublicMethods(append(api.DefaultPublicMethods, cfg.PublicMethods...)...)
+
+	checker := health.NewChecker(readinessTimeout)
+	checker.Add("postgres", rowSQLConn.PingContext)
+	checker.Add("redis", redisClient.Ping)
+	checker.Add("rabbitmq", rabbit.Ping)
 
 	// Запускаем сервер метрик на порту 9090
 	StartMetricsServer(":9090")
 
+	// токен, которым gateway помечает свои вызовы: им доверяется x-forwarded-for, и их не
+	// ограничивает load shedding gRPC
+	gatewayToken, err := api.NewGatewayToken()
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+
+	// унарные вызовы и потоки делят один лимит одновременных запросов
+	grpcLimiter := limiter.New("grpc", concurrencyConfig(cfg))
 	server := grpc.NewServer(
 		grpc.Creds(insecure.NewCredentials()),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 		grpc.ChainUnaryInterceptor(
-			logging.UnaryServerInterceptor(interceptorLogger(log), loggingOpts...),
+			api.GatewayInterceptor(gatewayToken),
+			api.RequestIDInterceptor(),
+			api.LoggingInterceptor(log),
+			logging.UnaryServerInterceptor(interceptorLogger(), loggingOpts...),
 			MetricsInterceptor(),
-			api.AuthInterceptor(cfg.JwtSecret),
+			api.DependencyErrorInterceptor(),
+			api.AuthInterceptor(settings.JwtSecret, publicMethods),
+			api.LoadSheddingInterceptor(grpcLimiter),
+			api.RateLimitInterceptor(redisClient, rateLimits(cfg)),
+			api.IdempotencyInterceptor(redisClient, cfg.IdempotencyTTL, pb.TwitterAPI_CreateTweet_FullMethodName),
+		),
+		grpc.ChainStreamInterceptor(
+			api.GatewayStreamInterceptor(gatewayToken),
+			api.RequestIDStreamInterceptor(),
+			api.LoggingStreamInterceptor(log),
+			logging.StreamServerInterceptor(interceptorLogger(), streamLoggingOpts...),
+			api.DependencyErrorStreamInterceptor(),
+			api.AuthStreamInterceptor(settings.JwtSecret, publicMethods),
+			api.LoadSheddingStreamInterceptor(grpcLimiter),
 		),
 	)
 	pb.RegisterTwitterAPIServer(server, &twitterGrpcServer)
 
+	healthServer := grpc_health.NewServer()
+	grpc_health_v1.RegisterHealthServer(server, healthServer)
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		checker.Run(ctx, healthServer, healthInterval, pb.TwitterAPI_ServiceDesc.ServiceName)
+	}()
+
 	log.Warn("GRPC server - started")
 	go func() {
-		if err = server.Serve(ln); err != nil {
-			// fmt.Println(err)
+		if err := server.Serve(ln); err != nil {
 			log.Error(err.Error())
+			cancel()
 		}
 	}()
 
 	conn, err := grpc.NewClient(cfg.HostGRPC,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithChainUnaryInterceptor(api.RequestIDClientInterceptor(), api.GatewayClientInterceptor(gatewayToken)),
+		grpc.WithChainStreamInterceptor(api.RequestIDStreamClientInterceptor(), api.GatewayStreamClientInterceptor(gatewayToken)),
 	)
 	if err != nil {
-		// fmt.Println(err)
-		log.Error(err.Error())
+		log.Error("grpc client init failed", "error", err)
+		os.Exit(1)
 	}
-	defer conn.Close()
 
 	// HTTP сервер (gRPC-gateway) с middleware
-	gw := grpc_run.NewServeMux()
+	gw := grpc_run.NewServeMux(
+		grpc_run.WithMetadata(api.ETagMetadata),
+		grpc_run.WithMetadata(api.IdempotencyMetadata),
+		grpc_run.WithForwardResponseOption(api.ETagResponseOption),
+		grpc_run.WithForwardResponseOption(api.RateLimitResponseOption),
+		grpc_run.WithOutgoingHeaderMatcher(api.OutgoingHeaderMatcher),
+		grpc_run.WithErrorHandler(api.ErrorHandler),
+	)
 	err = pb.RegisterTwitterAPIHandler(context.TODO(), gw, conn)
 	if err != nil {
-		// fmt.Println(err)
+		log.Error("gateway register failed", "error", err)
+		os.Exit(1)
+	}
+
+	// Загрузка и раздача медиа идут мимо сгенерированных обработчиков: multipart и бинарные ответы
+	err = gw.HandlePath("POST", "/media", api.MediaUploadHandler(gw, pb.NewTwitterAPIClient(conn)))
+	if err != nil {
 		log.Error(err.Error())
 	}
+	err = gw.HandlePath("GET", "/media/{id}", twitterGrpcServer.MediaDownloadHandler(gw, false))
+	if err != nil {
+		log.Error(err.Error())
+	}
+	err = gw.HandlePath("GET", "/media/{id}/thumbnail", twitterGrpcServer.MediaDownloadHandler(gw, true))
+	if err != nil {
+		log.Error(err.Error())
+	}
+
+	rateLimit := api.RateLimitMiddleware(redisClient, api.RateLimit(cfg.HTTPRateLimit), settings.JwtSecret)
+	loadShedding := api.LoadSheddingMiddleware(limiter.New("http", concurrencyConfig(cfg)), settings.JwtSecret)
+	wrappedMux := api.RequestIDMiddleware(api.MetricsMiddleware(loadShedding(rateLimit(api.ConditionalGetMiddleware(gw)))))
+
+	// пробы оркестратора идут мимо лимитов и метрик gateway
+	rootMux := http.NewServeMux()
+	rootMux.HandleFunc("/healthz", health.LivenessHandler)
+	rootMux.HandleFunc("/readyz", checker.ReadinessHandler)
+	rootMux.Handle("/", otelhttp.NewHandler(wrappedMux, "gateway"))
 
-	wrappedMux := api.MetricsMiddleware(gw)
 	gwServer := &http.Server{
 		Addr:    cfg.Host,
-		Handler: wrappedMux,
+		Handler: rootMux,
 	}
 
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		reloadOnSIGHUP(ctx, configStore, hup)
+	}()
+
 	log.Warn("GRPC-GW server - started")
-	err = gwServer.ListenAndServe()
-	if err != nil {
-		// fmt.Println(err)
-		log.Error(err.Error())
+	go func() {
+		if err := gwServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error(err.Error())
+			cancel()
+		}
+	}()
+
+	<-ctx.Done()
+	// повторный сигнал завершит процесс сразу
+	cancel()
+	log.Warn("shutdown started")
+
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+	defer shutdownCancel()
+
+	// сначала пробы перестают пропускать трафик, затем дожидаемся запросов в работе
+	healthServer.Shutdown()
+	var errs []error
+	if err := gwServer.Shutdown(shutdownCtx); err != nil {
+		errs = append(errs, fmt.Errorf("gateway shutdown: %w", err))
+	}
+	if err := stopGRPC(shutdownCtx, server); err != nil {
+		errs = append(errs, err)
+	}
+	if err := conn.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("gateway grpc client close: %w", err))
+	}
+	if err := waitWorkers(shutdownCtx, &workers); err != nil {
+		errs = append(errs, err)
+	}
+	// обработчики завершены, новых публикаций не будет
+	if err := producer.Flush(shutdownCtx); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := redisClient.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("redis close: %w", err))
+	}
+	if err := rowSQLConn.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("postgres close: %w", err))
+	}
+	rabbit.Close()
+	// спаны отправляем последними, чтобы попали и спаны остановки
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		errs = append(errs, fmt.Errorf("tracing shutdown: %w", err))
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		log.Error("shutdown failed", "error", err)
+		os.Exit(1)
+	}
+	log.Warn("shutdown completed")
+}
+
+// stopGRPC дожидается завершения запросов в работе, а по истечении ctx обрывает оставшиеся
+func stopGRPC(ctx context.Context, server *grpc.Server) error {
+	done := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		server.Stop()
+		return fmt.Errorf("grpc graceful stop: %w", ctx.Err())
+	}
+}
+
+// breakerConfig настройки breaker зависимости с дедлайном вызова timeout
+func breakerConfig(cfg config.Config, timeout time.Duration) breaker.Config {
+	return breaker.Config{
+		FailureThreshold: cfg.BreakerFailureThreshold,
+		OpenTimeout:      cfg.BreakerOpenTimeout,
+		Timeout:          timeout,
+	}
+}
+
+func concurrencyConfig(cfg config.Config) limiter.Config {
+	return limiter.Config{
+		InitialLimit:     cfg.ConcurrencyInitialLimit,
+		MinLimit:         cfg.ConcurrencyMinLimit,
+		MaxLimit:         cfg.ConcurrencyMaxLimit,
+		LatencyThreshold: cfg.ConcurrencyLatencyThreshold,
 	}
+}
 
+// rateLimits лимиты методов gRPC из конфига
+func rateLimits(cfg config.Config) map[string]api.RateLimit {
+	limits := make(map[string]api.RateLimit, len(cfg.RateLimits))
+	for method, rule := range cfg.RateLimits {
+		limits[method] = api.RateLimit(rule)
+	}
+	return limits
 }
 
-func interceptorLogger(l *slog.Logger) logging.Logger {
+func waitWorkers(ctx context.Context, workers *sync.WaitGroup) error {
+	done := make(chan struct{})
+	go func() {
+		workers.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("background workers: %w", ctx.Err())
+	}
+}
+
+// interceptorLogger пишет через логгер запроса, который кладет api.LoggingInterceptor
+func interceptorLogger() logging.Logger {
 	return logging.LoggerFunc(func(ctx context.Context, lvl logging.Level, msg string, fields ...any) {
-		l.Log(ctx, slog.Level(lvl), msg, fields...)
+		logger.FromContext(ctx).Log(ctx, slog.Level(lvl), msg, fields...)
 	})
 }
 
@@ -237,9 +524,10 @@ func StartMetricsServer(addr string) {
 	}()
 }
 
+// forceShutdown страховка на случай, если упорядоченная остановка зависла
 func forceShutdown(ctx context.Context) {
 	log := logger.FromContext(ctx)
-	const shutdownDelay = 15 * time.Second
+	const shutdownDelay = shutdownTimeout + 5*time.Second
 
 	<-ctx.Done()
 	time.Sleep(shutdownDelay)
@@ -247,3 +535,69 @@ func forceShutdown(ctx context.Context) {
 	log.Error("failed to graceful shutdown")
 	os.Exit(1)
 }
+
+// reloadOnSIGHUP перечитывает конфиг по сигналам из hup до отмены ctx
+func reloadOnSIGHUP(ctx context.Context, store *config.Store, hup <-chan os.Signal) {
+	log := logger.FromContext(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+		}
+		snapshot, ignored, err := store.Reload()
+		if err != nil {
+			metrics.ConfigReloadsTotal.WithLabelValues("error").Inc()
+			log.Error("config reload failed, keeping active config", "version", store.Current().Version, "error", err)
+			continue
+		}
+		metrics.ConfigReloadsTotal.WithLabelValues("success").Inc()
+		log.Warn("config reloaded", "version", snapshot.Version, "checksum", snapshot.Checksum)
+		if len(ignored) > 0 {
+			log.Warn("config changes require restart", "keys", ignored)
+		}
+	}
+}
+
+func runtimeSettings(snapshot config.Snapshot) api.RuntimeSettings {
+	cfg := snapshot.Config
+	return api.RuntimeSettings{
+		JwtSecret:          cfg.JwtSecret,
+		TweetCacheTTL:      cfg.TweetCacheTTL,
+		TweetNotFoundTTL:   cfg.TweetNotFoundTTL,
+		PollVotesTTL:       cfg.PollVotesTTL,
+		ClosedPollVotesTTL: cfg.ClosedPollVotesTTL,
+		UserTimelineMaxLen: cfg.UserTimelineMaxLen,
+		UserTimelineTTL:    cfg.UserTimelineTTL,
+		AdminUserIds:       cfg.AdminUserIds,
+		ConfigVersion:      snapshot.Version,
+		ConfigChecksum:     snapshot.Checksum,
+		ConfigLoadedAt:     snapshot.LoadedAt,
+	}
+}
+
+// runCommand выполняет подкоманду вместо запуска сервиса
+func runCommand(configPath string, args []string) error {
+	if len(args) >= 2 && args[0] == "config" && args[1] == "print" {
+		fs := flag.NewFlagSet("config print", flag.ContinueOnError)
+		redact := fs.Bool("redact", false, "hide passwords and secrets")
+		if err := fs.Parse(args[2:]); err != nil {
+			return err
+		}
+		cfg, err := config.Load(configPath)
+		if err != nil {
+			return err
+		}
+		if *redact {
+			cfg = cfg.Redacted()
+		}
+		out, err := yaml.Marshal(cfg)
+		if err != nil {
+			return err
+		}
+		_, err = os.Stdout.Write(out)
+		return err
+	}
+	return fmt.Errorf("unknown command %q, expected: config print [--redact]", strings.Join(args, " "))
+}
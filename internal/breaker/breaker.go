@@ -0,0 +1,187 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+	"twitter/internal/metrics"
+)
+
+// ErrOpen вызов отклонен без обращения к зависимости
+var ErrOpen = errors.New("circuit breaker is open")
+
+type State int
+
+// Состояния breaker. Значения экспортируются в метрику circuit_breaker_state
+const (
+	Closed State = iota
+	HalfOpen
+	Open
+)
+
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case HalfOpen:
+		return "half-open"
+	case Open:
+		return "open"
+	}
+	return "unknown"
+}
+
+// Config настройки breaker. Значения по умолчанию подставляет config сервиса
+type Config struct {
+	// FailureThreshold сколько ошибок подряд размыкает breaker
+	FailureThreshold int
+	// OpenTimeout сколько breaker остается разомкнутым, прежде чем пропустить пробный вызов
+	OpenTimeout time.Duration
+	// Timeout дедлайн одного вызова, отсчитывается от контекста запроса. 0 - без дедлайна
+	Timeout time.Duration
+}
+
+// Breaker защищает зависимость: после FailureThreshold ошибок подряд вызовы отклоняются
+// с ErrOpen, через OpenTimeout пропускается один пробный вызов, и его успех замыкает breaker.
+// Нулевой *Breaker пропускает все вызовы
+type Breaker struct {
+	name string
+	cfg  Config
+	// isFailure отличает отказ зависимости от ответа, который говорит о ее работоспособности,
+	// например отсутствующей строки или ключа
+	isFailure func(error) bool
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	// probing пробный вызов в полуоткрытом состоянии уже выполняется
+	probing bool
+	// generation растет при каждой смене состояния. Результаты вызовов, начатых в прошлом
+	// поколении, не учитываются: они ничего не говорят о текущем состоянии
+	generation uint64
+}
+
+// New создает breaker с именем name для метрик. isFailure nil считает отказом любую ошибку,
+// кроме отмены запроса клиентом
+func New(name string, cfg Config, isFailure func(error) bool) *Breaker {
+	if isFailure == nil {
+		isFailure = IsFailure
+	}
+	metrics.CircuitBreakerState.WithLabelValues(name).Set(float64(Closed))
+	return &Breaker{name: name, cfg: cfg, isFailure: isFailure}
+}
+
+// IsFailure классификатор по умолчанию
+func IsFailure(err error) bool {
+	return err != nil && !errors.Is(err, context.Canceled)
+}
+
+// Allow решает, можно ли обратиться к зависимости. Результат вызова передается в done
+func (b *Breaker) Allow() (done func(err error), err error) {
+	if b == nil {
+		return func(error) {}, nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case Open:
+		if time.Since(b.openedAt) < b.cfg.OpenTimeout {
+			metrics.CircuitBreakerRejectedTotal.WithLabelValues(b.name).Inc()
+			return nil, ErrOpen
+		}
+		b.setState(HalfOpen)
+		fallthrough
+	case HalfOpen:
+		if b.probing {
+			metrics.CircuitBreakerRejectedTotal.WithLabelValues(b.name).Inc()
+			return nil, ErrOpen
+		}
+		b.probing = true
+		return b.done(true, b.generation), nil
+	}
+	return b.done(false, b.generation), nil
+}
+
+func (b *Breaker) done(probe bool, generation uint64) func(err error) {
+	return func(err error) {
+		failed := b.isFailure(err)
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		// вызов начался до смены состояния, например медленный вызов из замкнутого состояния
+		// завершился, пока идет пробный
+		if generation != b.generation {
+			return
+		}
+		if probe {
+			b.probing = false
+			// отмененный клиентом пробный вызов ничего не говорит о зависимости:
+			// остаемся полуоткрытыми и пропускаем следующий
+			if errors.Is(err, context.Canceled) {
+				return
+			}
+		}
+		switch {
+		case !failed:
+			b.failures = 0
+			if b.state == HalfOpen {
+				b.setState(Closed)
+			}
+		case b.state == HalfOpen:
+			b.trip()
+		case b.state == Closed:
+			b.failures++
+			if b.failures >= b.cfg.FailureThreshold {
+				b.trip()
+			}
+		}
+	}
+}
+
+func (b *Breaker) trip() {
+	b.failures = 0
+	b.openedAt = time.Now()
+	b.setState(Open)
+}
+
+func (b *Breaker) setState(state State) {
+	b.state = state
+	b.generation++
+	metrics.CircuitBreakerState.WithLabelValues(b.name).Set(float64(state))
+}
+
+// State текущее состояние
+func (b *Breaker) State() State {
+	if b == nil {
+		return Closed
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// WithTimeout ограничивает контекст вызова дедлайном Config.Timeout. Более ранний дедлайн
+// запроса сохраняется
+func (b *Breaker) WithTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if b == nil || b.cfg.Timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, b.cfg.Timeout)
+}
+
+// Do выполняет fn с дедлайном, если breaker замкнут, и учитывает результат
+func (b *Breaker) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	done, err := b.Allow()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := b.WithTimeout(ctx)
+	defer cancel()
+	err = fn(ctx)
+	done(err)
+	return err
+}
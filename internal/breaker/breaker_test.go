@@ -0,0 +1,164 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+var errDependency = errors.New("dependency failed")
+
+func TestBreakerTransitions(t *testing.T) {
+	tests := []struct {
+		name string
+		// results результаты вызовов по порядку; nil - успех
+		results []error
+		// wait пауза перед последним вызовом
+		wait  time.Duration
+		state State
+	}{
+		{
+			name:    "successes keep closed",
+			results: []error{nil, nil, nil},
+			state:   Closed,
+		},
+		{
+			name:    "failures below threshold keep closed",
+			results: []error{errDependency, errDependency},
+			state:   Closed,
+		},
+		{
+			name:    "success resets failure count",
+			results: []error{errDependency, errDependency, nil, errDependency, errDependency},
+			state:   Closed,
+		},
+		{
+			name:    "threshold failures open",
+			results: []error{errDependency, errDependency, errDependency},
+			state:   Open,
+		},
+		{
+			name:    "canceled calls are not failures",
+			results: []error{context.Canceled, context.Canceled, context.Canceled},
+			state:   Closed,
+		},
+		{
+			name:    "successful probe closes",
+			results: []error{errDependency, errDependency, errDependency, nil},
+			wait:    20 * time.Millisecond,
+			state:   Closed,
+		},
+		{
+			name:    "failed probe opens again",
+			results: []error{errDependency, errDependency, errDependency, errDependency},
+			wait:    20 * time.Millisecond,
+			state:   Open,
+		},
+		{
+			name:    "canceled probe stays half-open",
+			results: []error{errDependency, errDependency, errDependency, context.Canceled},
+			wait:    20 * time.Millisecond,
+			state:   HalfOpen,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := New("test", Config{FailureThreshold: 3, OpenTimeout: 10 * time.Millisecond}, nil)
+			for i, result := range tt.results {
+				if i == len(tt.results)-1 {
+					time.Sleep(tt.wait)
+				}
+				err := b.Do(context.Background(), func(context.Context) error { return result })
+				if !errors.Is(err, result) {
+					t.Fatalf("call %d: got error %v, want %v", i, err, result)
+				}
+			}
+			if got := b.State(); got != tt.state {
+				t.Errorf("state = %s, want %s", got, tt.state)
+			}
+		})
+	}
+}
+
+func TestBreakerRejectsWhileOpen(t *testing.T) {
+	b := New("test", Config{FailureThreshold: 1, OpenTimeout: time.Hour}, nil)
+	_ = b.Do(context.Background(), func(context.Context) error { return errDependency })
+
+	called := false
+	err := b.Do(context.Background(), func(context.Context) error {
+		called = true
+		return nil
+	})
+	if !errors.Is(err, ErrOpen) {
+		t.Errorf("got error %v, want ErrOpen", err)
+	}
+	if called {
+		t.Error("call passed through an open breaker")
+	}
+}
+
+func TestBreakerSingleProbe(t *testing.T) {
+	b := New("test", Config{FailureThreshold: 1, OpenTimeout: time.Millisecond}, nil)
+	_ = b.Do(context.Background(), func(context.Context) error { return errDependency })
+	time.Sleep(5 * time.Millisecond)
+
+	done, err := b.Allow()
+	if err != nil {
+		t.Fatalf("probe rejected: %v", err)
+	}
+	if _, err := b.Allow(); !errors.Is(err, ErrOpen) {
+		t.Errorf("second call during probe: got %v, want ErrOpen", err)
+	}
+	done(context.Canceled)
+	if _, err := b.Allow(); err != nil {
+		t.Errorf("call after canceled probe: got %v, want a new probe", err)
+	}
+}
+
+func TestNilBreaker(t *testing.T) {
+	var b *Breaker
+	for range 10 {
+		if err := b.Do(context.Background(), func(context.Context) error { return errDependency }); !errors.Is(err, errDependency) {
+			t.Fatalf("got error %v, want dependency error", err)
+		}
+	}
+	if got := b.State(); got != Closed {
+		t.Errorf("state = %s, want closed", got)
+	}
+}
+
+func TestBreakerIgnoresResultsFromEarlierState(t *testing.T) {
+	tests := []struct {
+		name string
+		// late результат медленного вызова, начатого до размыкания
+		late error
+	}{
+		{name: "late success does not close", late: nil},
+		{name: "late failure does not reopen", late: errDependency},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := New("test", Config{FailureThreshold: 1, OpenTimeout: time.Millisecond}, nil)
+			slow, err := b.Allow()
+			if err != nil {
+				t.Fatalf("slow call rejected: %v", err)
+			}
+			_ = b.Do(context.Background(), func(context.Context) error { return errDependency })
+			time.Sleep(5 * time.Millisecond)
+
+			probe, err := b.Allow()
+			if err != nil {
+				t.Fatalf("probe rejected: %v", err)
+			}
+			slow(tt.late)
+			if got := b.State(); got != HalfOpen {
+				t.Fatalf("state after late result = %s, want half-open", got)
+			}
+			probe(nil)
+			if got := b.State(); got != Closed {
+				t.Errorf("state after probe = %s, want closed", got)
+			}
+		})
+	}
+}
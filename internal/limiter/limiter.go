@@ -0,0 +1,134 @@
+package limiter
+
+import (
+	"math"
+	"sync"
+	"time"
+	"twitter/internal/metrics"
+)
+
+const (
+	// backoffRatio во сколько раз уменьшается лимит при перегрузке
+	backoffRatio = 0.9
+)
+
+// Priority важность запроса. При приближении к лимиту первыми отклоняются менее важные
+type Priority int
+
+const (
+	PriorityLow Priority = iota
+	PriorityNormal
+	PriorityHigh
+)
+
+func (p Priority) String() string {
+	switch p {
+	case PriorityLow:
+		return "low"
+	case PriorityNormal:
+		return "normal"
+	case PriorityHigh:
+		return "high"
+	}
+	return "unknown"
+}
+
+// shares доля лимита, доступная запросам приоритета: остаток держится для более важных
+var shares = [...]float64{
+	PriorityLow:    0.75,
+	PriorityNormal: 0.9,
+	PriorityHigh:   1,
+}
+
+// Config настройки лимитера. Значения по умолчанию подставляет config сервиса
+type Config struct {
+	InitialLimit int
+	MinLimit     int
+	MaxLimit     int
+	// LatencyThreshold запрос дольше этого считается признаком перегрузки
+	LatencyThreshold time.Duration
+}
+
+// Limiter ограничивает число одновременных запросов по схеме AIMD: лимит растет на единицу после
+// успешного запроса, пока лимит используется хотя бы наполовину, и умножается на 0.9 после
+// медленного или неудачного из-за перегрузки запроса. Уменьшение применяется не чаще раза
+// за LatencyThreshold: запросы одного всплеска завершаются вместе и сообщают об одной перегрузке
+type Limiter struct {
+	name string
+	cfg  Config
+
+	mu       sync.Mutex
+	limit    float64
+	inflight int
+	// decreasedAt когда лимит уменьшался последний раз
+	decreasedAt time.Time
+}
+
+// New создает лимитер с именем name для метрик
+func New(name string, cfg Config) *Limiter {
+	l := &Limiter{
+		name:  name,
+		cfg:   cfg,
+		limit: math.Min(math.Max(float64(cfg.InitialLimit), float64(cfg.MinLimit)), float64(cfg.MaxLimit)),
+	}
+	metrics.ConcurrencyLimit.WithLabelValues(name).Set(math.Floor(l.limit))
+	return l
+}
+
+// Acquire занимает место под запрос. Если места нет, возвращает false и запрос нужно отклонить.
+// Иначе release вызывается по завершении запроса; overloaded сообщает, что запрос не выполнен
+// из-за перегрузки или недоступности зависимости
+func (l *Limiter) Acquire(p Priority) (release func(overloaded bool), ok bool) {
+	return l.acquire(p, true)
+}
+
+// AcquireUntimed то же для запросов, длительность которых зависит от клиента, например
+// передачи файлов или потоков: лимит снижается только по overloaded, а не по задержке
+func (l *Limiter) AcquireUntimed(p Priority) (release func(overloaded bool), ok bool) {
+	return l.acquire(p, false)
+}
+
+func (l *Limiter) acquire(p Priority, timed bool) (release func(overloaded bool), ok bool) {
+	l.mu.Lock()
+	if float64(l.inflight) >= math.Floor(l.limit*shares[p]) {
+		l.mu.Unlock()
+		metrics.LoadShedTotal.WithLabelValues(l.name, p.String()).Inc()
+		return nil, false
+	}
+	l.inflight++
+	inflight := l.inflight
+	l.mu.Unlock()
+	metrics.ConcurrencyInFlight.WithLabelValues(l.name).Inc()
+
+	start := time.Now()
+	return func(overloaded bool) {
+		l.release(inflight, overloaded || timed && time.Since(start) > l.cfg.LatencyThreshold)
+	}, true
+}
+
+func (l *Limiter) release(inflight int, overloaded bool) {
+	metrics.ConcurrencyInFlight.WithLabelValues(l.name).Dec()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.inflight--
+	switch {
+	case overloaded:
+		if now := time.Now(); now.Sub(l.decreasedAt) >= l.cfg.LatencyThreshold {
+			l.decreasedAt = now
+			l.limit = math.Max(l.limit*backoffRatio, float64(l.cfg.MinLimit))
+		}
+	// без нагрузки успешные запросы ничего не говорят о пределе
+	case float64(inflight)*2 >= l.limit:
+		l.limit = math.Min(l.limit+1, float64(l.cfg.MaxLimit))
+	}
+	metrics.ConcurrencyLimit.WithLabelValues(l.name).Set(math.Floor(l.limit))
+}
+
+// Limit текущий лимит
+func (l *Limiter) Limit() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return int(l.limit)
+}
@@ -0,0 +1,161 @@
+package limiter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLimiterAIMD(t *testing.T) {
+	tests := []struct {
+		name string
+		// inflight сколько запросов занято, прежде чем завершается последний
+		inflight   int
+		overloaded bool
+		want       int
+	}{
+		{name: "success under load grows limit", inflight: 10, want: 21},
+		{name: "success without load keeps limit", inflight: 5, want: 20},
+		{name: "overload shrinks limit", inflight: 5, overloaded: true, want: 18},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := New("test", Config{InitialLimit: 20, MinLimit: 1, MaxLimit: 100, LatencyThreshold: time.Hour})
+			var last func(bool)
+			for i := range tt.inflight {
+				release, ok := l.Acquire(PriorityHigh)
+				if !ok {
+					t.Fatalf("acquire %d rejected", i)
+				}
+				last = release
+			}
+			last(tt.overloaded)
+			if got := l.Limit(); got != tt.want {
+				t.Errorf("limit = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLimiterDecreasesOncePerWindow(t *testing.T) {
+	l := New("test", Config{InitialLimit: 100, MinLimit: 1, MaxLimit: 100, LatencyThreshold: 20 * time.Millisecond})
+	var releases []func(bool)
+	for range 50 {
+		release, _ := l.Acquire(PriorityHigh)
+		releases = append(releases, release)
+	}
+	for _, release := range releases {
+		release(true)
+	}
+	if got := l.Limit(); got != 90 {
+		t.Fatalf("limit after burst = %d, want 90", got)
+	}
+
+	time.Sleep(25 * time.Millisecond)
+	release, _ := l.Acquire(PriorityHigh)
+	release(true)
+	if got := l.Limit(); got != 81 {
+		t.Errorf("limit after next window = %d, want 81", got)
+	}
+}
+
+func TestLimiterBounds(t *testing.T) {
+	l := New("test", Config{InitialLimit: 4, MinLimit: 3, MaxLimit: 5, LatencyThreshold: time.Millisecond})
+	for range 10 {
+		release, _ := l.Acquire(PriorityHigh)
+		time.Sleep(2 * time.Millisecond)
+		release(true)
+	}
+	if got := l.Limit(); got != 3 {
+		t.Errorf("limit after overload = %d, want min 3", got)
+	}
+
+	l = New("test", Config{InitialLimit: 3, MinLimit: 3, MaxLimit: 5, LatencyThreshold: time.Hour})
+	for range 10 {
+		var releases []func(bool)
+		for range 3 {
+			release, ok := l.Acquire(PriorityHigh)
+			if !ok {
+				break
+			}
+			releases = append(releases, release)
+		}
+		for i := len(releases) - 1; i >= 0; i-- {
+			releases[i](false)
+		}
+	}
+	if got := l.Limit(); got != 5 {
+		t.Errorf("limit after successes = %d, want max 5", got)
+	}
+}
+
+func TestLimiterLatency(t *testing.T) {
+	tests := []struct {
+		name    string
+		untimed bool
+		want    int
+	}{
+		{name: "slow request shrinks limit", want: 9},
+		{name: "slow untimed request keeps limit", untimed: true, want: 11},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := New("test", Config{InitialLimit: 10, MinLimit: 1, MaxLimit: 100, LatencyThreshold: time.Millisecond})
+			acquire := l.Acquire
+			if tt.untimed {
+				acquire = l.AcquireUntimed
+			}
+			var releases []func(bool)
+			for range 5 {
+				release, _ := acquire(PriorityHigh)
+				releases = append(releases, release)
+			}
+			time.Sleep(5 * time.Millisecond)
+			releases[len(releases)-1](false)
+			if got := l.Limit(); got != tt.want {
+				t.Errorf("limit = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLimiterPriorityShares(t *testing.T) {
+	tests := []struct {
+		priority Priority
+		// want сколько запросов пропускается при лимите 100
+		want int
+	}{
+		{priority: PriorityLow, want: 75},
+		{priority: PriorityNormal, want: 90},
+		{priority: PriorityHigh, want: 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.priority.String(), func(t *testing.T) {
+			l := New("test", Config{InitialLimit: 100, MinLimit: 1, MaxLimit: 100, LatencyThreshold: time.Hour})
+			admitted := 0
+			for {
+				if _, ok := l.Acquire(tt.priority); !ok {
+					break
+				}
+				admitted++
+			}
+			if admitted != tt.want {
+				t.Errorf("admitted %d, want %d", admitted, tt.want)
+			}
+		})
+	}
+}
+
+func TestLimiterReservesForHighPriority(t *testing.T) {
+	l := New("test", Config{InitialLimit: 10, MinLimit: 1, MaxLimit: 100, LatencyThreshold: time.Hour})
+	for {
+		if _, ok := l.Acquire(PriorityLow); !ok {
+			break
+		}
+	}
+	if _, ok := l.Acquire(PriorityNormal); !ok {
+		t.Error("normal priority rejected while low priority fills its share")
+	}
+	if _, ok := l.Acquire(PriorityHigh); !ok {
+		t.Error("high priority rejected while lower priorities fill their shares")
+	}
+}
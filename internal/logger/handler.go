@@ -0,0 +1,32 @@
+package logger
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+)
+
+// Форматы вывода логов
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// New создает логгер в формате format с уровнем level. Пользовательский текст в записях
+// скрывается, а записи с контекстом трассировки получают trace_id
+func New(w io.Writer, format string, level slog.Leveler) (*slog.Logger, error) {
+	opts := &slog.HandlerOptions{
+		Level:       level,
+		ReplaceAttr: RedactAttr,
+	}
+	var handler slog.Handler
+	switch format {
+	case "", FormatText:
+		handler = slog.NewTextHandler(w, opts)
+	case FormatJSON:
+		handler = slog.NewJSONHandler(w, opts)
+	default:
+		return nil, fmt.Errorf("unknown log format %q", format)
+	}
+	return slog.New(WithTrace(handler)), nil
+}
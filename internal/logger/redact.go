@@ -0,0 +1,84 @@
+package logger
+
+import (
+	"fmt"
+	"log/slog"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// RedactedFields строковые поля с пользовательским текстом. В логи попадает только их длина,
+// и в атрибутах записи, и в полях сообщений protobuf
+var RedactedFields = map[string]bool{
+	"text":         true,
+	"alt_text":     true,
+	"bio":          true,
+	"options":      true,
+	"display_name": true,
+	"location":     true,
+}
+
+func redactedString(s string) string {
+	return fmt.Sprintf("[redacted %d chars]", len([]rune(s)))
+}
+
+// RedactAttr функция для slog.HandlerOptions.ReplaceAttr. Скрывает значения полей из RedactedFields,
+// сообщения protobuf (например, тела запросов gRPC) выводит в JSON без этих полей
+func RedactAttr(_ []string, a slog.Attr) slog.Attr {
+	switch a.Value.Kind() {
+	case slog.KindString:
+		if RedactedFields[a.Key] {
+			return slog.String(a.Key, redactedString(a.Value.String()))
+		}
+	case slog.KindAny:
+		if m, ok := a.Value.Any().(proto.Message); ok {
+			return slog.String(a.Key, RedactProto(m))
+		}
+	}
+	return a
+}
+
+// RedactProto возвращает сообщение в protojson, заменяя текст в полях из RedactedFields
+func RedactProto(m proto.Message) string {
+	if m == nil {
+		return ""
+	}
+	clone := proto.Clone(m)
+	redactMessage(clone.ProtoReflect())
+	out, err := protojson.Marshal(clone)
+	if err != nil {
+		return fmt.Sprintf("%T", m)
+	}
+	return string(out)
+}
+
+func redactMessage(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList() && fd.Kind() == protoreflect.MessageKind:
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				redactMessage(list.Get(i).Message())
+			}
+		case fd.IsList() && fd.Kind() == protoreflect.StringKind && RedactedFields[string(fd.Name())]:
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				list.Set(i, protoreflect.ValueOfString(redactedString(list.Get(i).String())))
+			}
+		case fd.IsMap():
+			if fd.MapValue().Kind() == protoreflect.MessageKind {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					redactMessage(mv.Message())
+					return true
+				})
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			redactMessage(v.Message())
+		case fd.Kind() == protoreflect.StringKind && RedactedFields[string(fd.Name())]:
+			m.Set(fd, protoreflect.ValueOfString(redactedString(v.String())))
+		}
+		return true
+	})
+}
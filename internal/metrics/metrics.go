@@ -29,4 +29,55 @@ var (
 		Help:    "Duration of HTTP requests",
 		Buckets: prometheus.DefBuckets,
 	}, []string{"method", "path"})
+
+	// Метрики ограничения частоты запросов
+	RateLimitedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "rate_limited_requests_total",
+		Help: "Total number of requests rejected by rate limiting",
+	}, []string{"scope"})
+
+	// Метрики перезагрузки конфига
+	ConfigReloadsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "config_reloads_total",
+		Help: "Total number of configuration reloads",
+	}, []string{"result"})
+
+	// Метрики кэша по слоям: result - hit, miss или error
+	CacheLookupsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cache_lookups_total",
+		Help: "Total number of cache lookups by layer and result",
+	}, []string{"cache", "layer", "result"})
+
+	// Метрики circuit breaker: состояние 0 - closed, 1 - half-open, 2 - open
+	CircuitBreakerState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "circuit_breaker_state",
+		Help: "Current circuit breaker state: 0 closed, 1 half-open, 2 open",
+	}, []string{"dependency"})
+
+	CircuitBreakerRejectedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "circuit_breaker_rejected_total",
+		Help: "Total number of calls rejected by an open circuit breaker",
+	}, []string{"dependency"})
+
+	// Метрики адаптивного ограничения параллельных запросов
+	ConcurrencyLimit = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "concurrency_limit",
+		Help: "Current adaptive concurrency limit",
+	}, []string{"limiter"})
+
+	ConcurrencyInFlight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "concurrency_in_flight",
+		Help: "Number of requests currently admitted by the concurrency limiter",
+	}, []string{"limiter"})
+
+	LoadShedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "load_shed_requests_total",
+		Help: "Total number of requests rejected by the concurrency limiter",
+	}, []string{"limiter", "priority"})
+
+	// QueuedEvents события, ожидающие повторной публикации в RabbitMQ
+	QueuedEvents = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "rabbitmq_queued_events",
+		Help: "Number of events waiting to be republished to RabbitMQ",
+	})
 )
@@ -1,19 +1,40 @@
 package rabbitmq
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"sync"
+	"time"
+	"twitter/internal/logger"
 
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
 const (
 	MessageQueue = "message"
+	// TweetDeletedQueue события удаления твитов для фоновой очистки связанных данных
+	TweetDeletedQueue = "tweet_deleted"
 )
 
-// RabbitMQClient обертка для работы с RabbitMQ
+// Пауза между попытками переподключиться растет вдвое от MinReconnectDelay до MaxReconnectDelay
+const (
+	MinReconnectDelay = 100 * time.Millisecond
+	MaxReconnectDelay = 30 * time.Second
+)
+
+// ErrClosed клиент закрыт или соединение с брокером еще не восстановлено
+var ErrClosed = errors.New("rabbitmq connection is closed")
+
+// RabbitMQClient обертка для работы с RabbitMQ. Соединение и канал публикации
+// восстанавливаются в Run после разрыва, поэтому их нужно брать через Channel
 type RabbitMQClient struct {
-	Conn *amqp.Connection
-	Ch   *amqp.Channel
+	url string
+
+	mu     sync.RWMutex
+	conn   *amqp.Connection
+	ch     *amqp.Channel
+	closed bool
 }
 
 // NewRabbitMQClient создает нового клиента RabbitMQ
@@ -28,18 +49,55 @@ func NewRabbitMQClient(host string, port string, username string, password strin
 		vHost,
 	)
 
-	conn, err := amqp.Dial(url)
-	if err != nil {
+	c := &RabbitMQClient{url: url}
+	if err := c.connect(); err != nil {
 		return nil, err
 	}
+	return c, nil
+}
+
+// connect открывает соединение и канал публикации в режиме подтверждений, объявляет очереди
+// и подменяет ими текущие
+func (c *RabbitMQClient) connect() error {
+	conn, err := amqp.Dial(c.url)
+	if err != nil {
+		return err
+	}
 
 	ch, err := conn.Channel()
 	if err != nil {
-		return nil, err
+		conn.Close()
+		return err
 	}
+	if err := declareQueues(ch); err != nil {
+		conn.Close()
+		return err
+	}
+	// подтверждения нужны, чтобы публикация узнала, что брокер принял сообщение
+	if err := ch.Confirm(false); err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to enable publisher confirms: %w", err)
+	}
+
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		conn.Close()
+		return ErrClosed
+	}
+	old := c.conn
+	c.conn, c.ch = conn, ch
+	c.mu.Unlock()
+
+	if old != nil {
+		old.Close()
+	}
+	return nil
+}
 
+func declareQueues(ch *amqp.Channel) error {
 	// Объявляем очередь для сообщений
-	_, err = ch.QueueDeclare(
+	_, err := ch.QueueDeclare(
 		MessageQueue,
 		false,
 		false,
@@ -48,18 +106,104 @@ func NewRabbitMQClient(host string, port string, username string, password strin
 		nil,
 	)
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	// События удаления не должны теряться при перезапуске брокера
+	_, err = ch.QueueDeclare(
+		TweetDeletedQueue,
+		true,
+		false,
+		false,
+		false,
+		nil,
+	)
+	return err
+}
+
+// Run следит за соединением и каналом публикации и переподключается после их закрытия,
+// пока не отменен ctx
+func (c *RabbitMQClient) Run(ctx context.Context) {
+	log := logger.FromContext(ctx)
+	for {
+		c.mu.RLock()
+		conn, ch := c.conn, c.ch
+		c.mu.RUnlock()
+
+		// на уже закрытых соединении и канале NotifyClose сразу закрывает канал уведомлений
+		connClosed := conn.NotifyClose(make(chan *amqp.Error, 1))
+		chClosed := ch.NotifyClose(make(chan *amqp.Error, 1))
+		var reason *amqp.Error
+		select {
+		case <-ctx.Done():
+			return
+		case reason = <-connClosed:
+		case reason = <-chClosed:
+		}
+		log.WarnContext(ctx, "rabbitmq connection lost, reconnecting", "error", reason)
+
+		delay := MinReconnectDelay
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+			err := c.connect()
+			if err == nil {
+				break
+			}
+			if errors.Is(err, ErrClosed) {
+				return
+			}
+			log.WarnContext(ctx, "rabbitmq reconnect failed, retrying", "error", err, "delay", delay)
+			delay = min(delay*2, MaxReconnectDelay)
+		}
+		log.InfoContext(ctx, "rabbitmq reconnected")
 	}
+}
 
-	return &RabbitMQClient{Conn: conn, Ch: ch}, nil
+// Channel текущий канал публикации. Канал работает в режиме подтверждений
+func (c *RabbitMQClient) Channel() (*amqp.Channel, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.closed || c.ch.IsClosed() {
+		return nil, ErrClosed
+	}
+	return c.ch, nil
 }
 
-// Close закрывает соединение с RabbitMQ
-func (c *RabbitMQClient) Close() {
-	if c.Ch != nil {
-		c.Ch.Close()
+// OpenChannel открывает отдельный канал на текущем соединении, например для потребителя
+func (c *RabbitMQClient) OpenChannel() (*amqp.Channel, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.closed || c.conn.IsClosed() {
+		return nil, ErrClosed
+	}
+	return c.conn.Channel()
+}
+
+// Ping проверяет, что соединение и канал публикации открыты
+func (c *RabbitMQClient) Ping(ctx context.Context) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.closed || c.conn.IsClosed() {
+		return errors.New("rabbitmq connection is closed")
+	}
+	if c.ch.IsClosed() {
+		return errors.New("rabbitmq channel is closed")
 	}
-	if c.Conn != nil {
-		c.Conn.Close()
+	return nil
+}
+
+// Close закрывает соединение с RabbitMQ. Переподключений после него не будет
+func (c *RabbitMQClient) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return
 	}
+	c.closed = true
+	c.ch.Close()
+	c.conn.Close()
 }
@@ -0,0 +1,56 @@
+package requestid
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+const (
+	// Header заголовок HTTP с идентификатором запроса
+	Header = "X-Request-ID"
+	// MetadataKey ключ метаданных gRPC с идентификатором запроса
+	MetadataKey = "x-request-id"
+	// maxLength ограничение на длину идентификатора, переданного клиентом
+	maxLength = 128
+)
+
+type ctxMarker struct{}
+
+// NewContext returns context with request ID.
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxMarker{}, id)
+}
+
+// FromContext returns request ID from context or empty string.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(ctxMarker{}).(string)
+	return id
+}
+
+// New генерирует идентификатор запроса
+func New() string {
+	return uuid.Must(uuid.NewV4()).String()
+}
+
+// Valid проверяет идентификатор, пришедший от клиента: видимые символы ASCII без пробелов,
+// чтобы его можно было безопасно писать в логи и заголовки
+func Valid(id string) bool {
+	if id == "" || len(id) > maxLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// Ensure возвращает id, если он допустим, иначе новый идентификатор
+func Ensure(id string) string {
+	if Valid(id) {
+		return id
+	}
+	return New()
+}
@@ -0,0 +1,3 @@
+drop table if exists poll_votes;
+drop table if exists poll_options;
+drop table if exists polls;
@@ -0,0 +1,25 @@
+create table polls
+(
+    tweet_id        uuid      not null references tweets (id) on delete cascade,
+    closes_at       timestamp not null,
+    created_at      timestamp not null default now(),
+    primary key (tweet_id)
+);
+
+create table poll_options
+(
+    tweet_id        uuid      not null references polls (tweet_id) on delete cascade,
+    position        integer   not null,
+    text            text      not null,
+    votes           bigint    not null default 0,
+    primary key (tweet_id, position)
+);
+
+create table poll_votes
+(
+    tweet_id        uuid      not null references polls (tweet_id) on delete cascade,
+    user_id         uuid      not null,
+    position        integer   not null,
+    created_at      timestamp not null default now(),
+    primary key (tweet_id, user_id)
+);
@@ -0,0 +1,2 @@
+drop table if exists tweet_media;
+drop table if exists media;
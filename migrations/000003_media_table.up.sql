@@ -0,0 +1,20 @@
+create table media
+(
+    id              uuid      not null default gen_random_uuid(),
+    user_id         uuid      not null,
+    mime_type       text      not null,
+    size            bigint    not null,
+    width           integer   not null,
+    height          integer   not null,
+    created_at      timestamp not null default now(),
+    primary key (id)
+);
+
+create table tweet_media
+(
+    tweet_id        uuid      not null references tweets (id) on delete cascade,
+    media_id        uuid      not null references media (id),
+    position        integer   not null,
+    alt_text        text      not null default '',
+    primary key (tweet_id, position)
+);
@@ -0,0 +1,22 @@
+create table bookmark_folders
+(
+    id              uuid      not null default gen_random_uuid(),
+    user_id         uuid      not null,
+    name            text      not null,
+    created_at      timestamp not null default now(),
+    primary key (id),
+    unique (user_id, name)
+);
+
+-- ссылки на tweets нет намеренно: закладки чистятся по событию удаления твита
+create table bookmarks
+(
+    user_id         uuid      not null,
+    tweet_id        uuid      not null,
+    folder_id       uuid      references bookmark_folders (id) on delete set null,
+    created_at      timestamp not null default now(),
+    primary key (user_id, tweet_id)
+);
+
+create index bookmarks_user_created_idx on bookmarks (user_id, created_at desc, tweet_id desc);
+create index bookmarks_tweet_idx on bookmarks (tweet_id);
@@ -0,0 +1,3 @@
+drop index if exists tweets_user_created_idx;
+drop table if exists list_members;
+drop table if exists lists;
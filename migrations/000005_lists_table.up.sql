@@ -0,0 +1,24 @@
+create table lists
+(
+    id              uuid      not null default gen_random_uuid(),
+    owner_id        uuid      not null,
+    name            text      not null,
+    description     text      not null default '',
+    private         boolean   not null default false,
+    created_at      timestamp not null default now(),
+    updated_at      timestamp not null default now(),
+    primary key (id)
+);
+
+create index lists_owner_idx on lists (owner_id);
+
+create table list_members
+(
+    list_id         uuid      not null references lists (id) on delete cascade,
+    user_id         uuid      not null,
+    created_at      timestamp not null default now(),
+    primary key (list_id, user_id)
+);
+
+-- ленты по спискам авторов: user_id = ANY ($1) order by created_at desc
+create index tweets_user_created_idx on tweets (user_id, created_at desc, id desc);
@@ -0,0 +1,2 @@
+drop table if exists mutes;
+drop table if exists blocks;
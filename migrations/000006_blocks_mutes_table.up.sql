@@ -0,0 +1,18 @@
+create table blocks
+(
+    user_id         uuid      not null,
+    target_id       uuid      not null,
+    created_at      timestamp not null default now(),
+    primary key (user_id, target_id)
+);
+
+-- проверка "автор заблокировал читателя"
+create index blocks_target_idx on blocks (target_id, user_id);
+
+create table mutes
+(
+    user_id         uuid      not null,
+    target_id       uuid      not null,
+    created_at      timestamp not null default now(),
+    primary key (user_id, target_id)
+);
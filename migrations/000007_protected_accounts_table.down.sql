@@ -0,0 +1,2 @@
+drop table if exists account_access;
+drop table if exists protected_accounts;
@@ -0,0 +1,16 @@
+create table protected_accounts
+(
+    user_id         uuid      not null,
+    created_at      timestamp not null default now(),
+    primary key (user_id)
+);
+
+create table account_access
+(
+    owner_id        uuid      not null,
+    reader_id       uuid      not null,
+    status          text      not null check (status in ('pending', 'approved')),
+    created_at      timestamp not null default now(),
+    updated_at      timestamp not null default now(),
+    primary key (owner_id, reader_id)
+);
@@ -0,0 +1,16 @@
+create table users
+(
+    id              uuid      not null,
+    username        text      not null,
+    display_name    text      not null default '',
+    bio             text      not null default '',
+    avatar_url      text      not null default '',
+    location        text      not null default '',
+    website         text      not null default '',
+    created_at      timestamp not null default now(),
+    updated_at      timestamp not null default now(),
+    primary key (id)
+);
+
+-- имена уникальны без учета регистра
+create unique index users_username_idx on users (lower(username));
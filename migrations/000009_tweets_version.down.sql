@@ -0,0 +1 @@
+alter table tweets drop column if exists version;
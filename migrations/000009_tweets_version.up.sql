@@ -0,0 +1 @@
+alter table tweets add column version bigint not null default 1;